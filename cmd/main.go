@@ -4,17 +4,15 @@ import (
 	"fmt"
 	"os"
 	"os/user"
+	"path/filepath"
+	"strconv"
 
+	"github.com/RafaLopesMelo/monkey-lang/internal/evaluator"
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
 	"github.com/RafaLopesMelo/monkey-lang/internal/repl"
 )
 
 func main() {
-	user, err := user.Current()
-
-	if err != nil {
-		panic(err)
-	}
-
 	args := os.Args
 	kind := ""
 
@@ -22,6 +20,51 @@ func main() {
 		kind = args[1]
 	}
 
+	// Extra directories to look for imported modules in, e.g. MONKEY_PATH=./lib:/usr/share/monkey
+	if path := os.Getenv("MONKEY_PATH"); path != "" {
+		evaluator.SearchPath = filepath.SplitList(path)
+	}
+
+	// Fixed seed for math.random, making runs reproducible, e.g. MONKEY_SEED=42
+	if seed := os.Getenv("MONKEY_SEED"); seed != "" {
+		value, err := strconv.ParseInt(seed, 10, 64)
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid MONKEY_SEED %q: must be an integer\n", seed)
+			os.Exit(1)
+		}
+
+		evaluator.SetRandomSeed(value)
+	}
+
+	// Out of range indexes and slices become errors instead of null, e.g. MONKEY_STRICT=1
+	if strict := os.Getenv("MONKEY_STRICT"); strict != "" {
+		value, err := strconv.ParseBool(strict)
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "invalid MONKEY_STRICT %q: must be a boolean\n", strict)
+			os.Exit(1)
+		}
+
+		evaluator.Strict = value
+	}
+
+	if kind == "run" {
+		if len(args) < 3 {
+			fmt.Fprintln(os.Stderr, "usage: main run <file>")
+			os.Exit(1)
+		}
+
+		runFile(args[2])
+		return
+	}
+
+	user, err := user.Current()
+
+	if err != nil {
+		panic(err)
+	}
+
 	fmt.Printf("Hello, %s! This is the Monkey programming language!\n", user.Username)
 	fmt.Printf("Feel free to type in commands\n")
 
@@ -33,3 +76,17 @@ func main() {
 		repl.StartRepl(os.Stdin, os.Stdout)
 	}
 }
+
+func runFile(path string) {
+	result := evaluator.EvalFile(path)
+
+	if err, ok := result.(*object.Error); ok {
+		fmt.Fprintln(os.Stderr, err.Inspect())
+
+		for _, frame := range err.Stack {
+			fmt.Fprintln(os.Stderr, "\tat "+frame)
+		}
+
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,32 @@
+package ast
+
+import (
+	"bytes"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+type AssignExpression struct {
+	Token    token.Token // The assignment operator token, e.g. '=' or '+='
+	Target   Expression  // The binding being updated
+	Operator string
+	Value    Expression
+}
+
+func (ae *AssignExpression) expressionNode() {}
+
+func (ae *AssignExpression) TokenLiteral() string {
+	return ae.Token.Literal
+}
+
+func (ae *AssignExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(ae.Target.String())
+	out.WriteString(" " + ae.Operator + " ")
+	out.WriteString(ae.Value.String())
+	out.WriteString(")")
+
+	return out.String()
+}
@@ -11,6 +11,7 @@ type CallExpression struct {
 	Token     token.Token // The "(" token
 	Function  Expression  // Identifier or FunctionLiteral
 	Arguments []Expression
+	Optional  bool // f?.(), which evaluates to null instead of failing when f is null
 }
 
 func (ce *CallExpression) expressionNode() {}
@@ -28,6 +29,11 @@ func (ce *CallExpression) String() string {
 	}
 
 	out.WriteString(ce.Function.String())
+
+	if ce.Optional {
+		out.WriteString("?.")
+	}
+
 	out.WriteString("(")
 	out.WriteString(strings.Join(args, ", "))
 	out.WriteString(")")
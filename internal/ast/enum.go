@@ -0,0 +1,60 @@
+package ast
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+// Declares an enum, binding it to Name and each of its variants to their own names, e.g.
+// enum Shape { Circle(r), Rect(w, h), Empty }
+type EnumStatement struct {
+	Token    token.Token // The "enum" token
+	Name     *Identifier
+	Variants []*EnumVariant
+}
+
+type EnumVariant struct {
+	Name   *Identifier
+	Fields []*Identifier // nil for variants carrying no values, e.g. Empty
+}
+
+func (es *EnumStatement) statementNode() {}
+
+func (es *EnumStatement) TokenLiteral() string {
+	return es.Token.Literal
+}
+
+func (es *EnumStatement) String() string {
+	var out bytes.Buffer
+
+	variants := []string{}
+	for _, variant := range es.Variants {
+		variants = append(variants, variant.String())
+	}
+
+	out.WriteString(es.TokenLiteral() + " ")
+	out.WriteString(es.Name.String())
+
+	if len(variants) == 0 {
+		out.WriteString(" {}")
+	} else {
+		out.WriteString(" { " + strings.Join(variants, ", ") + " }")
+	}
+
+	return out.String()
+}
+
+func (ev *EnumVariant) String() string {
+	if ev.Fields == nil {
+		return ev.Name.String()
+	}
+
+	fields := []string{}
+	for _, field := range ev.Fields {
+		fields = append(fields, field.String())
+	}
+
+	return ev.Name.String() + "(" + strings.Join(fields, ", ") + ")"
+}
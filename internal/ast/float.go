@@ -0,0 +1,20 @@
+package ast
+
+import (
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+type FloatLiteral struct {
+	Token token.Token
+	Value float64
+}
+
+func (fl *FloatLiteral) expressionNode() {}
+
+func (fl *FloatLiteral) TokenLiteral() string {
+	return fl.Token.Literal
+}
+
+func (fl *FloatLiteral) String() string {
+	return fl.TokenLiteral()
+}
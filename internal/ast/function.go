@@ -9,7 +9,7 @@ import (
 
 type FunctionLiteral struct {
 	Token      token.Token // The "fn" token
-	Parameters []*Identifier
+	Parameters []Pattern
 	Body       *BlockStatement
 }
 
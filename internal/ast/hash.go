@@ -7,9 +7,14 @@ import (
 	"github.com/RafaLopesMelo/monkey-lang/internal/token"
 )
 
+type HashLiteralPair struct {
+	Key   Expression
+	Value Expression
+}
+
 type HashLiteral struct {
 	Token token.Token // The '{' token
-	Pairs map[Expression]Expression
+	Pairs []HashLiteralPair
 }
 
 func (hl *HashLiteral) expressionNode() {}
@@ -22,8 +27,8 @@ func (hl *HashLiteral) String() string {
 	var out bytes.Buffer
 
 	pairs := []string{}
-	for key, value := range hl.Pairs {
-		pairs = append(pairs, key.String()+": "+value.String())
+	for _, pair := range hl.Pairs {
+		pairs = append(pairs, pair.Key.String()+": "+pair.Value.String())
 	}
 
 	out.WriteString(hl.TokenLiteral())
@@ -9,6 +9,8 @@ type Identifier struct {
 
 func (i *Identifier) expressionNode() {}
 
+func (i *Identifier) patternNode() {}
+
 func (i *Identifier) TokenLiteral() string {
 	return i.Token.Literal
 }
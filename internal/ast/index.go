@@ -7,9 +7,10 @@ import (
 )
 
 type IndexExpression struct {
-	Token token.Token // The [ token
-	Left  Expression
-	Index Expression
+	Token    token.Token // The [ token
+	Left     Expression
+	Index    Expression
+	Optional bool // a?.[k], which evaluates to null instead of failing when a is null
 }
 
 func (ie *IndexExpression) expressionNode() {}
@@ -23,6 +24,11 @@ func (ie *IndexExpression) String() string {
 
 	out.WriteString("(")
 	out.WriteString(ie.Left.String())
+
+	if ie.Optional {
+		out.WriteString("?.")
+	}
+
 	out.WriteString("[")
 	out.WriteString(ie.Index.String())
 	out.WriteString("])")
@@ -7,8 +7,8 @@ import (
 )
 
 type LetStatement struct {
-	Token token.Token
-	Name  *Identifier
+	Token token.Token // The let or const token
+	Name  Pattern     // Identifier, or array/hash/constructor pattern when destructuring
 	Value Expression
 }
 
@@ -18,6 +18,11 @@ func (ls *LetStatement) TokenLiteral() string {
 	return ls.Token.Literal
 }
 
+// Const declarations can't be reassigned or redeclared in the same scope
+func (ls *LetStatement) IsConst() bool {
+	return ls.Token.Type == token.CONST
+}
+
 func (ls *LetStatement) String() string {
 	var out bytes.Buffer
 
@@ -0,0 +1,36 @@
+package ast
+
+import (
+	"bytes"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+type MemberExpression struct {
+	Token    token.Token // The . token
+	Object   Expression
+	Property *Identifier
+	Optional bool // a?.b, which evaluates to null instead of failing when the object is null
+}
+
+func (me *MemberExpression) expressionNode() {}
+
+func (me *MemberExpression) TokenLiteral() string {
+	return me.Token.Literal
+}
+
+func (me *MemberExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(me.Object.String())
+	if me.Optional {
+		out.WriteString("?")
+	}
+
+	out.WriteString(".")
+	out.WriteString(me.Property.String())
+	out.WriteString(")")
+
+	return out.String()
+}
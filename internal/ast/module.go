@@ -0,0 +1,46 @@
+package ast
+
+import (
+	"bytes"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+type ImportStatement struct {
+	Token token.Token // The "import" token
+	Path  *StringLiteral
+	Alias *Identifier
+}
+
+func (is *ImportStatement) statementNode() {}
+
+func (is *ImportStatement) TokenLiteral() string {
+	return is.Token.Literal
+}
+
+func (is *ImportStatement) String() string {
+	var out bytes.Buffer
+
+	out.WriteString(is.TokenLiteral() + " ")
+	out.WriteString("\"" + is.Path.Value + "\"")
+	out.WriteString(" as ")
+	out.WriteString(is.Alias.String())
+	out.WriteString(";")
+
+	return out.String()
+}
+
+type ExportStatement struct {
+	Token     token.Token // The "export" token
+	Statement Statement   // The exported declaration
+}
+
+func (es *ExportStatement) statementNode() {}
+
+func (es *ExportStatement) TokenLiteral() string {
+	return es.Token.Literal
+}
+
+func (es *ExportStatement) String() string {
+	return es.TokenLiteral() + " " + es.Statement.String()
+}
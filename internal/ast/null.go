@@ -0,0 +1,17 @@
+package ast
+
+import "github.com/RafaLopesMelo/monkey-lang/internal/token"
+
+type NullLiteral struct {
+	Token token.Token
+}
+
+func (nl *NullLiteral) expressionNode() {}
+
+func (nl *NullLiteral) TokenLiteral() string {
+	return nl.Token.Literal
+}
+
+func (nl *NullLiteral) String() string {
+	return nl.Token.Literal
+}
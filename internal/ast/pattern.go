@@ -0,0 +1,153 @@
+package ast
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+// Describes the shape a value is destructured into. Identifiers are the simplest patterns,
+// binding the whole value to a name
+type Pattern interface {
+	Node
+	patternNode()
+}
+
+type ArrayPattern struct {
+	Token    token.Token // The [ token
+	Elements []Pattern
+	Rest     *Identifier // Binds the remaining elements, e.g. ...tail. Optional
+}
+
+func (ap *ArrayPattern) patternNode() {}
+
+func (ap *ArrayPattern) TokenLiteral() string {
+	return ap.Token.Literal
+}
+
+func (ap *ArrayPattern) String() string {
+	elements := []string{}
+
+	for _, e := range ap.Elements {
+		elements = append(elements, e.String())
+	}
+
+	if ap.Rest != nil {
+		elements = append(elements, "..."+ap.Rest.String())
+	}
+
+	return "[" + strings.Join(elements, ", ") + "]"
+}
+
+type HashPatternPair struct {
+	Key   Expression // Identifiers are used as string keys, e.g. {name} reads the "name" key
+	Value Pattern
+}
+
+type HashPattern struct {
+	Token token.Token // The { token
+	Pairs []HashPatternPair
+}
+
+func (hp *HashPattern) patternNode() {}
+
+func (hp *HashPattern) TokenLiteral() string {
+	return hp.Token.Literal
+}
+
+func (hp *HashPattern) String() string {
+	var out bytes.Buffer
+
+	pairs := []string{}
+	for _, pair := range hp.Pairs {
+		key, ok := pair.Key.(*Identifier)
+
+		if ok && key.String() == pair.Value.String() {
+			pairs = append(pairs, key.String())
+		} else {
+			pairs = append(pairs, pair.Key.String()+": "+pair.Value.String())
+		}
+	}
+
+	out.WriteString("{")
+	out.WriteString(strings.Join(pairs, ", "))
+	out.WriteString("}")
+
+	return out.String()
+}
+
+// Matches values equal to a literal, e.g. 1, -1, "circle" or true
+type LiteralPattern struct {
+	Token token.Token // The first token of the literal
+	Value Expression
+}
+
+func (lp *LiteralPattern) patternNode() {}
+
+func (lp *LiteralPattern) TokenLiteral() string {
+	return lp.Token.Literal
+}
+
+func (lp *LiteralPattern) String() string {
+	return lp.Value.String()
+}
+
+// Matches when any of the alternatives matches, e.g. 1 | 2 | 3
+type AlternativePattern struct {
+	Token        token.Token // The first token of the first alternative
+	Alternatives []Pattern
+}
+
+func (ap *AlternativePattern) patternNode() {}
+
+func (ap *AlternativePattern) TokenLiteral() string {
+	return ap.Token.Literal
+}
+
+func (ap *AlternativePattern) String() string {
+	alternatives := []string{}
+
+	for _, a := range ap.Alternatives {
+		alternatives = append(alternatives, a.String())
+	}
+
+	return strings.Join(alternatives, " | ")
+}
+
+// Matches an enum variant or a struct by name, destructuring its values by position, e.g.
+// Circle(r), Shape.Rect(w, _) or Point(x, 0). Without arguments, as in Shape.Empty, only the
+// variant is checked.
+type ConstructorPattern struct {
+	Token     token.Token // The first identifier
+	Qualifier *Identifier // The enum name in Shape.Circle(r). Optional
+	Name      *Identifier
+	Arguments []Pattern // nil when written without parentheses
+}
+
+func (cp *ConstructorPattern) patternNode() {}
+
+func (cp *ConstructorPattern) TokenLiteral() string {
+	return cp.Token.Literal
+}
+
+func (cp *ConstructorPattern) String() string {
+	var out bytes.Buffer
+
+	if cp.Qualifier != nil {
+		out.WriteString(cp.Qualifier.String() + ".")
+	}
+
+	out.WriteString(cp.Name.String())
+
+	if cp.Arguments != nil {
+		arguments := []string{}
+		for _, a := range cp.Arguments {
+			arguments = append(arguments, a.String())
+		}
+
+		out.WriteString("(" + strings.Join(arguments, ", ") + ")")
+	}
+
+	return out.String()
+}
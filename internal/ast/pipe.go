@@ -0,0 +1,33 @@
+package ast
+
+import (
+	"bytes"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+// Passes Left as the first argument of Right, e.g. xs |> map(f) is map(xs, f). Right may also be
+// any expression evaluating to a function, which is then called with Left alone, e.g. xs |> len.
+type PipeExpression struct {
+	Token token.Token // The |> token
+	Left  Expression
+	Right Expression
+}
+
+func (pe *PipeExpression) expressionNode() {}
+
+func (pe *PipeExpression) TokenLiteral() string {
+	return pe.Token.Literal
+}
+
+func (pe *PipeExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(pe.Left.String())
+	out.WriteString(" |> ")
+	out.WriteString(pe.Right.String())
+	out.WriteString(")")
+
+	return out.String()
+}
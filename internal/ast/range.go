@@ -0,0 +1,39 @@
+package ast
+
+import (
+	"bytes"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+type RangeExpression struct {
+	Token     token.Token // The .. or ..= token
+	Start     Expression
+	End       Expression
+	Step      Expression // nil when omitted
+	Inclusive bool       // Whether End is part of the range, e.g. 0..=10
+}
+
+func (re *RangeExpression) expressionNode() {}
+
+func (re *RangeExpression) TokenLiteral() string {
+	return re.Token.Literal
+}
+
+func (re *RangeExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(re.Start.String())
+	out.WriteString(re.TokenLiteral())
+	out.WriteString(re.End.String())
+
+	if re.Step != nil {
+		out.WriteString(" step ")
+		out.WriteString(re.Step.String())
+	}
+
+	out.WriteString(")")
+
+	return out.String()
+}
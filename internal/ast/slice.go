@@ -0,0 +1,42 @@
+package ast
+
+import (
+	"bytes"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+type SliceExpression struct {
+	Token token.Token // The [ token
+	Left  Expression
+	Start Expression // nil when omitted, e.g. s[:2]
+	End   Expression // nil when omitted, e.g. s[2:]
+}
+
+func (se *SliceExpression) expressionNode() {}
+
+func (se *SliceExpression) TokenLiteral() string {
+	return se.Token.Literal
+}
+
+func (se *SliceExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("(")
+	out.WriteString(se.Left.String())
+	out.WriteString("[")
+
+	if se.Start != nil {
+		out.WriteString(se.Start.String())
+	}
+
+	out.WriteString(":")
+
+	if se.End != nil {
+		out.WriteString(se.End.String())
+	}
+
+	out.WriteString("])")
+
+	return out.String()
+}
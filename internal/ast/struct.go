@@ -0,0 +1,41 @@
+package ast
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+// Declares a struct type, binding its constructor to Name, e.g. struct Point { x, y }
+type StructStatement struct {
+	Token  token.Token // The "struct" token
+	Name   *Identifier
+	Fields []*Identifier
+}
+
+func (ss *StructStatement) statementNode() {}
+
+func (ss *StructStatement) TokenLiteral() string {
+	return ss.Token.Literal
+}
+
+func (ss *StructStatement) String() string {
+	var out bytes.Buffer
+
+	fields := []string{}
+	for _, field := range ss.Fields {
+		fields = append(fields, field.String())
+	}
+
+	out.WriteString(ss.TokenLiteral() + " ")
+	out.WriteString(ss.Name.String())
+
+	if len(fields) == 0 {
+		out.WriteString(" {}")
+	} else {
+		out.WriteString(" { " + strings.Join(fields, ", ") + " }")
+	}
+
+	return out.String()
+}
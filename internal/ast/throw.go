@@ -0,0 +1,27 @@
+package ast
+
+import (
+	"bytes"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+type ThrowExpression struct {
+	Token token.Token // The "throw" token
+	Value Expression
+}
+
+func (te *ThrowExpression) expressionNode() {}
+
+func (te *ThrowExpression) TokenLiteral() string {
+	return te.Token.Literal
+}
+
+func (te *ThrowExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString(te.TokenLiteral() + " ")
+	out.WriteString(te.Value.String())
+
+	return out.String()
+}
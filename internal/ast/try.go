@@ -0,0 +1,46 @@
+package ast
+
+import (
+	"bytes"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/token"
+)
+
+type TryExpression struct {
+	Token      token.Token // The "try" token
+	Block      *BlockStatement
+	CatchParam Pattern         // Binds the caught error. Optional
+	Catch      *BlockStatement // Optional when Finally is present
+	Finally    *BlockStatement // Optional when Catch is present
+}
+
+func (te *TryExpression) expressionNode() {}
+
+func (te *TryExpression) TokenLiteral() string {
+	return te.Token.Literal
+}
+
+func (te *TryExpression) String() string {
+	var out bytes.Buffer
+
+	out.WriteString("try ")
+	out.WriteString(te.Block.String())
+
+	if te.Catch != nil {
+		out.WriteString(" catch")
+
+		if te.CatchParam != nil {
+			out.WriteString(" (" + te.CatchParam.String() + ")")
+		}
+
+		out.WriteString(" ")
+		out.WriteString(te.Catch.String())
+	}
+
+	if te.Finally != nil {
+		out.WriteString(" finally ")
+		out.WriteString(te.Finally.String())
+	}
+
+	return out.String()
+}
@@ -0,0 +1,36 @@
+package evaluator
+
+import "testing"
+
+func TestArrowFunctions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`let double = x => x * 2; double(4)`, "8"},
+		{`let add = (a, b) => a + b; add(2, 3)`, "5"},
+		{`let answer = () => 42; answer()`, "42"},
+		{`(x => x + 1)(1)`, "2"},
+		{`map([1, 2, 3], x => x * x)`, "[1, 4, 9]"},
+		{`[1, 2, 3, 4] |> filter(x => x % 2 == 0) |> map(x => x * 10)`, "[20, 40]"},
+		{`reduce([1, 2, 3], 0, (total, x) => total + x)`, "6"},
+		{`sort([3, 1, 2], (a, b) => b - a)`, "[3, 2, 1]"},
+		{`let add = a => b => a + b; add(1)(2)`, "3"},
+		{`let f = (x) => { let y = x * 2; y + 1 }; f(3)`, "7"},
+		{`let f = x => { if (x > 0) { return "positive" }; "other" }; [f(1), f(-1)]`, "[positive, other]"},
+		{`let first_name = ({name}) => name; first_name({"name": "Ana"})`, "Ana"},
+		{`let head = ([h, ...t]) => h; head([7, 8])`, "7"},
+		{`let n = 10; let add_n = x => x + n; add_n(5)`, "15"},
+		{`let abs = x => x < 0 ? -x : x; map([-1, 2], abs)`, "[1, 2]"},
+		{`match ([1, 2]) { xs if any(xs, x => x > 1) => "some", _ => "none" }`, "some"},
+		{`let f = x => x; f(1) == fn(x) { x }(1)`, "true"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
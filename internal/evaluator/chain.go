@@ -0,0 +1,89 @@
+package evaluator
+
+import (
+	"github.com/RafaLopesMelo/monkey-lang/internal/ast"
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+// Evaluates a chain of calls, indexes and member accesses such as a?.b.c(x). Once an optional link
+// finds null the rest of the chain is skipped, which is reported through short, so a?.b.c is null
+// rather than an error when a is null.
+func evalChain(node ast.Expression, env *object.Environment) (object.Object, bool) {
+	switch node := node.(type) {
+	case *ast.CallExpression:
+		fn, receiver, short := evalCallee(node.Function, node.Optional, env)
+		if short || isError(fn) {
+			return fn, short
+		}
+
+		args := evalExpressions(node.Arguments, env)
+
+		if len(args) == 1 && isError(args[0]) {
+			return args[0], false
+		}
+
+		result := applyMethod(fn, receiver, args)
+
+		if err, ok := result.(*object.Error); ok {
+			err.Stack = append(err.Stack, node.String())
+		}
+
+		return result, false
+	case *ast.IndexExpression:
+		left, short := evalChainLink(node.Left, node.Optional, env)
+		if short || isError(left) {
+			return left, short
+		}
+
+		index := Eval(node.Index, env)
+		if isError(index) {
+			return index, false
+		}
+
+		return evalIndexExpression(left, index), false
+	case *ast.MemberExpression:
+		obj, short := evalChainLink(node.Object, node.Optional, env)
+		if short || isError(obj) {
+			return obj, short
+		}
+
+		return evalMemberExpression(obj, node.Property), false
+	default:
+		return Eval(node, env), false
+	}
+}
+
+// Evaluates the function a call calls. For calls such as p.norm() the object the function was read
+// from is returned too, as the receiver of the call
+func evalCallee(function ast.Expression, optional bool, env *object.Environment) (object.Object, object.Object, bool) {
+	member, ok := function.(*ast.MemberExpression)
+	if !ok {
+		fn, short := evalChainLink(function, optional, env)
+		return fn, nil, short
+	}
+
+	receiver, short := evalChainLink(member.Object, member.Optional, env)
+	if short || isError(receiver) {
+		return receiver, nil, short
+	}
+
+	fn := evalMemberExpression(receiver, member.Property)
+
+	if optional && fn == NULL {
+		return NULL, nil, true
+	}
+
+	return fn, receiver, false
+}
+
+// Evaluates what a link of the chain operates on, short-circuiting when the link is optional and
+// that is null
+func evalChainLink(node ast.Expression, optional bool, env *object.Environment) (object.Object, bool) {
+	obj, short := evalChain(node, env)
+
+	if short || (optional && obj == NULL) {
+		return NULL, true
+	}
+
+	return obj, false
+}
@@ -0,0 +1,626 @@
+package evaluator
+
+import (
+	"math"
+	"sort"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+// Collection builtins call back into user functions through applyFunction, which reaches the
+// builtins map itself, so they are registered on init to avoid an initialization cycle
+func init() {
+	collections := map[string]object.BuiltinFunction{
+		"map":      builtinMap,
+		"filter":   builtinFilter,
+		"reduce":   builtinReduce,
+		"sort":     builtinSort,
+		"reverse":  builtinReverse,
+		"slice":    builtinSlice,
+		"concat":   builtinConcat,
+		"index_of": builtinIndexOf,
+		"contains": builtinContains,
+		"unique":   builtinUnique,
+		"flatten":  builtinFlatten,
+		"chunk":    builtinChunk,
+		"group_by": builtinGroupBy,
+		"to_array": builtinToArray,
+	}
+
+	for name, fn := range collections {
+		builtins[name] = &object.Builtin{Fn: fn}
+	}
+}
+
+func builtinMap(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	seq, fn, err := sequenceAndFunctionArguments("map", args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	if err := rangeLengthError(args[0], seq.Len()); err != nil {
+		return err
+	}
+
+	elements := make([]object.Object, 0, seq.Len())
+
+	for idx := range seq.Len() {
+		result := applyFunction(fn, []object.Object{seq.At(idx)})
+		if isError(result) {
+			return result
+		}
+
+		elements = append(elements, result)
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+func builtinFilter(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	seq, fn, err := sequenceAndFunctionArguments("filter", args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	elements := []object.Object{}
+
+	for idx := range seq.Len() {
+		element := seq.At(idx)
+
+		result := applyFunction(fn, []object.Object{element})
+		if isError(result) {
+			return result
+		}
+
+		if isTruthy(result) {
+			elements = append(elements, element)
+		}
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+func builtinReduce(args ...object.Object) object.Object {
+	if len(args) != 3 {
+		return newError("wrong number of arguments. got=%d, want=3", len(args))
+	}
+
+	seq, fn, err := sequenceAndFunctionArguments("reduce", args[0], args[2])
+	if err != nil {
+		return err
+	}
+
+	result := args[1]
+
+	for idx := range seq.Len() {
+		result = applyFunction(fn, []object.Object{result, seq.At(idx)})
+		if isError(result) {
+			return result
+		}
+	}
+
+	return result
+}
+
+// Sorts a copy of the array, either by the natural order of numbers and strings or by a comparator
+// returning a negative integer, zero or a positive integer like the ones in Go's cmp package
+func builtinSort(args ...object.Object) object.Object {
+	if len(args) != 1 && len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=1 or 2", len(args))
+	}
+
+	arr, err := arrayArgument("sort", args[0])
+	if err != nil {
+		return err
+	}
+
+	compare := compareNatural
+
+	if len(args) == 2 {
+		if !isCallable(args[1]) {
+			return newError("argument to `sort` not supported, got %s", args[1].Type())
+		}
+
+		compare = func(a, b object.Object) (int, *object.Error) {
+			return compareWith(args[1], a, b)
+		}
+	}
+
+	elements := make([]object.Object, len(arr.Elements))
+	copy(elements, arr.Elements)
+
+	var sortErr *object.Error
+
+	sort.SliceStable(elements, func(i, j int) bool {
+		if sortErr != nil {
+			return false
+		}
+
+		result, err := compare(elements[i], elements[j])
+		if err != nil {
+			sortErr = err
+			return false
+		}
+
+		return result < 0
+	})
+
+	if sortErr != nil {
+		return sortErr
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+func compareNatural(a, b object.Object) (int, *object.Error) {
+	switch {
+	case a.Type() == object.INTEGER_OBJ && b.Type() == object.INTEGER_OBJ:
+		left, right := a.(*object.Integer).Value, b.(*object.Integer).Value
+		return compareOrdered(left, right), nil
+	case isNumber(a) && isNumber(b):
+		left, _ := toFloat(a)
+		right, _ := toFloat(b)
+		return compareOrdered(left, right), nil
+	case a.Type() == object.STRING_OBJ && b.Type() == object.STRING_OBJ:
+		left, right := a.(*object.String).Value, b.(*object.String).Value
+		return compareOrdered(left, right), nil
+	default:
+		return 0, newError("cannot compare %s with %s", a.Type(), b.Type())
+	}
+}
+
+func compareWith(fn object.Object, a, b object.Object) (int, *object.Error) {
+	result := applyFunction(fn, []object.Object{a, b})
+	if err, ok := result.(*object.Error); ok {
+		return 0, err
+	}
+
+	integer, ok := result.(*object.Integer)
+	if !ok {
+		return 0, newError("sort comparator must return INTEGER, got %s", result.Type())
+	}
+
+	return int(integer.Value), nil
+}
+
+func compareOrdered[T int64 | float64 | string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func builtinReverse(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	seq, err := sequenceArgument("reverse", args[0])
+	if err != nil {
+		return err
+	}
+
+	length := seq.Len()
+
+	if err := rangeLengthError(args[0], length); err != nil {
+		return err
+	}
+
+	elements := make([]object.Object, length)
+
+	for idx := range length {
+		elements[length-idx-1] = seq.At(idx)
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+// Copies the elements from start up to, but not including, end. Negative positions count from the
+// end of the array and positions out of its bounds are clamped to them.
+func builtinSlice(args ...object.Object) object.Object {
+	if len(args) != 2 && len(args) != 3 {
+		return newError("wrong number of arguments. got=%d, want=2 or 3", len(args))
+	}
+
+	seq, err := sequenceArgument("slice", args[0])
+	if err != nil {
+		return err
+	}
+
+	length := seq.Len()
+	bounds := []int64{0, length}
+
+	for idx, arg := range args[1:] {
+		integer, ok := arg.(*object.Integer)
+		if !ok {
+			return newError("argument to `slice` not supported, got %s", arg.Type())
+		}
+
+		bounds[idx] = clampPosition(integer.Value, length)
+	}
+
+	start, end := bounds[0], bounds[1]
+	if start > end {
+		end = start
+	}
+
+	if err := rangeLengthError(args[0], end-start); err != nil {
+		return err
+	}
+
+	elements := make([]object.Object, 0, end-start)
+	for idx := start; idx < end; idx++ {
+		elements = append(elements, seq.At(idx))
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+func clampPosition(position int64, length int64) int64 {
+	if position < 0 {
+		position += length
+	}
+
+	return max(0, min(position, length))
+}
+
+func builtinConcat(args ...object.Object) object.Object {
+	elements := []object.Object{}
+
+	for _, arg := range args {
+		seq, err := sequenceArgument("concat", arg)
+		if err != nil {
+			return err
+		}
+
+		if err := rangeLengthError(arg, seq.Len()); err != nil {
+			return err
+		}
+
+		for idx := range seq.Len() {
+			elements = append(elements, seq.At(idx))
+		}
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+func builtinIndexOf(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	if str, ok := args[0].(*object.String); ok {
+		return stringIndexOf(str, args[1])
+	}
+
+	if r, ok := args[0].(*object.Range); ok {
+		return &object.Integer{Value: rangeIndexOf(r, args[1])}
+	}
+
+	arr, err := arrayArgument("index_of", args[0])
+	if err != nil {
+		return err
+	}
+
+	return &object.Integer{Value: int64(indexOf(arr.Elements, args[1]))}
+}
+
+func builtinContains(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	if str, ok := args[0].(*object.String); ok {
+		return stringContains(str, args[1])
+	}
+
+	if r, ok := args[0].(*object.Range); ok {
+		return nativeBoolToBooleanObject(rangeIndexOf(r, args[1]) != -1)
+	}
+
+	arr, err := arrayArgument("contains", args[0])
+	if err != nil {
+		return err
+	}
+
+	return nativeBoolToBooleanObject(indexOf(arr.Elements, args[1]) != -1)
+}
+
+func indexOf(elements []object.Object, value object.Object) int {
+	for idx, element := range elements {
+		if object.Equal(element, value) {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// Finds a number in a range arithmetically instead of walking it. Floats are found when they are
+// whole, matching how == compares integers and floats.
+func rangeIndexOf(r *object.Range, value object.Object) int64 {
+	switch value := value.(type) {
+	case *object.Integer:
+		return r.IndexOf(value.Value)
+	case *object.Float:
+		if value.Value == math.Trunc(value.Value) && math.Abs(value.Value) < math.MaxInt64 {
+			return r.IndexOf(int64(value.Value))
+		}
+	}
+
+	return -1
+}
+
+// Keeps the first occurrence of every element, preserving their order
+func builtinUnique(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	seq, err := sequenceArgument("unique", args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := rangeLengthError(args[0], seq.Len()); err != nil {
+		return err
+	}
+
+	seen := object.NewHash()
+	elements := []object.Object{}
+
+	for idx := range seq.Len() {
+		element := seq.At(idx)
+
+		if key, ok := object.AsHashable(element); ok {
+			if _, found := seen.Get(key); found {
+				continue
+			}
+
+			seen.Set(key, TRUE)
+		} else if indexOf(elements, element) != -1 {
+			continue
+		}
+
+		elements = append(elements, element)
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+// Flattens nested arrays by a single level
+func builtinFlatten(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	seq, err := sequenceArgument("flatten", args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := rangeLengthError(args[0], seq.Len()); err != nil {
+		return err
+	}
+
+	elements := []object.Object{}
+
+	for idx := range seq.Len() {
+		element := seq.At(idx)
+
+		if nested, ok := element.(*object.Array); ok {
+			elements = append(elements, nested.Elements...)
+		} else {
+			elements = append(elements, element)
+		}
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+// Splits the array into arrays of size elements, the last one holding whatever is left
+func builtinChunk(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	seq, err := sequenceArgument("chunk", args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := rangeLengthError(args[0], seq.Len()); err != nil {
+		return err
+	}
+
+	size, ok := args[1].(*object.Integer)
+	if !ok {
+		return newError("argument to `chunk` not supported, got %s", args[1].Type())
+	}
+
+	if size.Value < 1 {
+		return newError("chunk size must be positive, got %d", size.Value)
+	}
+
+	chunks := []object.Object{}
+	length := seq.Len()
+
+	// Steps by the length of each chunk, since adding a huge size to start could overflow
+	for start := int64(0); start < length; {
+		end := start + min(size.Value, length-start)
+
+		elements := make([]object.Object, 0, end-start)
+		for idx := start; idx < end; idx++ {
+			elements = append(elements, seq.At(idx))
+		}
+
+		chunks = append(chunks, &object.Array{Elements: elements})
+		start = end
+	}
+
+	return &object.Array{Elements: chunks}
+}
+
+// Groups the elements into a hash whose keys are what fn returns for each of them
+func builtinGroupBy(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	seq, fn, err := sequenceAndFunctionArguments("group_by", args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	groups := object.NewHash()
+
+	for idx := range seq.Len() {
+		element := seq.At(idx)
+
+		result := applyFunction(fn, []object.Object{element})
+		if isError(result) {
+			return result
+		}
+
+		key, ok := object.AsHashable(result)
+		if !ok {
+			return newError("unusable as hash key: %s", result.Type())
+		}
+
+		group, ok := groups.Get(key)
+		if !ok {
+			group = &object.Array{Elements: []object.Object{}}
+			groups.Set(key, group)
+		}
+
+		members := group.(*object.Array)
+		members.Elements = append(members.Elements, element)
+	}
+
+	return groups
+}
+
+// Most elements a builtin produces from a range, so huge ranges are errors rather than exhausting
+// memory
+const maxRangeArrayLength = 1 << 24
+
+// Accepts an array or a range, which is materialized for builtins needing every element at once.
+// Builtins that only walk their argument use sequenceArgument instead.
+func arrayArgument(name string, arg object.Object) (*object.Array, *object.Error) {
+	if r, ok := arg.(*object.Range); ok {
+		if err := rangeLengthError(r, r.Len()); err != nil {
+			return nil, err
+		}
+
+		return r.ToArray(), nil
+	}
+
+	arr, ok := arg.(*object.Array)
+	if !ok {
+		return nil, newError("argument to `%s` not supported, got %s", name, arg.Type())
+	}
+
+	return arr, nil
+}
+
+// Refuses to produce length elements from arg when it is a range and that many can't fit in memory
+func rangeLengthError(arg object.Object, length int64) *object.Error {
+	r, ok := arg.(*object.Range)
+	if !ok || length <= maxRangeArrayLength {
+		return nil
+	}
+
+	return newError("range %s is too long to turn into an array", r.Inspect())
+}
+
+// The elements of an array or a range, which builtins that only walk their argument go through
+// without materializing the range
+type sequence struct {
+	elements []object.Object
+	r        *object.Range
+}
+
+func (s sequence) Len() int64 {
+	if s.r != nil {
+		return s.r.Len()
+	}
+
+	return int64(len(s.elements))
+}
+
+// Returns the element at idx, which must be between 0 and Len()
+func (s sequence) At(idx int64) object.Object {
+	if s.r != nil {
+		return &object.Integer{Value: s.r.At(idx)}
+	}
+
+	return s.elements[idx]
+}
+
+func sequenceArgument(name string, arg object.Object) (sequence, *object.Error) {
+	switch arg := arg.(type) {
+	case *object.Range:
+		return sequence{r: arg}, nil
+	case *object.Array:
+		return sequence{elements: arg.Elements}, nil
+	default:
+		return sequence{}, newError("argument to `%s` not supported, got %s", name, arg.Type())
+	}
+}
+
+func sequenceAndFunctionArguments(name string, arg object.Object, fn object.Object) (sequence, object.Object, *object.Error) {
+	seq, err := sequenceArgument(name, arg)
+	if err != nil {
+		return sequence{}, nil, err
+	}
+
+	if !isCallable(fn) {
+		return sequence{}, nil, newError("argument to `%s` not supported, got %s", name, fn.Type())
+	}
+
+	return seq, fn, nil
+}
+
+func isCallable(obj object.Object) bool {
+	switch obj.(type) {
+	case *object.Function, *object.Builtin, *object.StructType, *object.EnumVariant:
+		return true
+	default:
+		return false
+	}
+}
+
+// Materializes a range into an array. Arrays are returned as a copy, so the result is always safe
+// to mutate.
+func builtinToArray(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	arr, err := arrayArgument("to_array", args[0])
+	if err != nil {
+		return err
+	}
+
+	if arr == args[0] {
+		elements := make([]object.Object, len(arr.Elements))
+		copy(elements, arr.Elements)
+
+		return &object.Array{Elements: elements}
+	}
+
+	return arr
+}
@@ -0,0 +1,110 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestCollectionBuiltins(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`map([1, 2, 3], fn(x) { x * 2 })`, "[2, 4, 6]"},
+		{`map([], fn(x) { x * 2 })`, "[]"},
+		{`map([[1], [1, 2]], len)`, "[1, 2]"},
+		{`filter([1, 2, 3, 4], fn(x) { x % 2 == 0 })`, "[2, 4]"},
+		{`filter([1, 3], fn(x) { x % 2 == 0 })`, "[]"},
+		{`reduce([1, 2, 3, 4], 0, fn(acc, x) { acc + x })`, "10"},
+		{`reduce([], 42, fn(acc, x) { acc + x })`, "42"},
+		{`reduce(["a", "b"], "", fn(acc, x) { acc + x })`, "ab"},
+		{`sort([3, 1, 2])`, "[1, 2, 3]"},
+		{`sort(["b", "c", "a"])`, "[a, b, c]"},
+		{`sort([1, 2, 3], fn(a, b) { b - a })`, "[3, 2, 1]"},
+		{`sort([[2, "a"], [1, "b"], [2, "c"]], fn(a, b) { a[0] - b[0] })`, "[[1, b], [2, a], [2, c]]"},
+		{`let arr = [2, 1]; sort(arr); arr`, "[2, 1]"},
+		{`reverse([1, 2, 3])`, "[3, 2, 1]"},
+		{`reverse([])`, "[]"},
+		{`slice([1, 2, 3, 4], 1)`, "[2, 3, 4]"},
+		{`slice([1, 2, 3, 4], 1, 3)`, "[2, 3]"},
+		{`slice([1, 2, 3, 4], -2)`, "[3, 4]"},
+		{`slice([1, 2, 3, 4], 0, -1)`, "[1, 2, 3]"},
+		{`slice([1, 2, 3], 2, 10)`, "[3]"},
+		{`slice([1, 2, 3], 2, 1)`, "[]"},
+		{`concat([1], [2, 3], [])`, "[1, 2, 3]"},
+		{`concat()`, "[]"},
+		{`index_of([1, 2, 3], 2)`, "1"},
+		{`index_of(["a", "b"], "b")`, "1"},
+		{`index_of([1, 2, 3], 4)`, "-1"},
+		{`index_of([1], "1")`, "-1"},
+		{`contains([1, 2, 3], 3)`, "true"},
+		{`contains([true], false)`, "false"},
+		{`let f = fn() {}; contains([f], f)`, "true"},
+		{`unique([1, 2, 1, 3, 2])`, "[1, 2, 3]"},
+		{`unique(["a", 1, "a", true, true])`, "[a, 1, true]"},
+		{`let a = [1]; unique([a, a, [1]])`, "[[1]]"},
+		{`unique([[1], {"a": 2}, [2], {"a": 2}, [1]])`, "[[1], {a: 2}, [2]]"},
+		{`index_of([[1], [2]], [2])`, "1"},
+		{`contains([{"a": [1]}], {"a": [1]})`, "true"},
+		{`flatten([1, [2, 3], [], [[4]]])`, "[1, 2, 3, [4]]"},
+		{`chunk([1, 2, 3, 4, 5], 2)`, "[[1, 2], [3, 4], [5]]"},
+		{`chunk([], 3)`, "[]"},
+		{`let g = group_by([1, 2, 3, 4, 5], fn(x) { x % 2 }); [g[0], g[1]]`, "[[2, 4], [1, 3, 5]]"},
+		{`group_by([], fn(x) { x })`, "{}"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated == nil {
+			t.Errorf("%s evaluated to nil", tt.input)
+			continue
+		}
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestCollectionBuiltinErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`map([1], 1)`, "argument to `map` not supported, got INTEGER"},
+		{`map(1, fn(x) { x })`, "argument to `map` not supported, got INTEGER"},
+		{`map([1])`, "wrong number of arguments. got=1, want=2"},
+		{`map([1, 0], fn(x) { 10 / x })`, "division by zero: 10 / 0"},
+		{`filter([1], fn(x) { throw "nope" })`, "nope"},
+		{`reduce([1], fn(acc, x) { acc })`, "wrong number of arguments. got=2, want=3"},
+		{`sort([true, false])`, "cannot compare BOOLEAN with BOOLEAN"},
+		{`sort([1, 2], fn(a, b) { true })`, "sort comparator must return INTEGER, got BOOLEAN"},
+		{`sort([1, 2], 1)`, "argument to `sort` not supported, got INTEGER"},
+		{`slice([1], "a")`, "argument to `slice` not supported, got STRING"},
+		{`concat([1], 2)`, "argument to `concat` not supported, got INTEGER"},
+		{`chunk([1], 0)`, "chunk size must be positive, got 0"},
+		{`group_by([1], fn(x) { [x] })`, "unusable as hash key: ARRAY"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
+
+func TestCollectionBuiltinCallbackErrorsAreCatchable(t *testing.T) {
+	input := `try { map([1, 2], fn(x) { throw x }) } catch (e) { e.value + 10 }`
+
+	testIntegerObject(t, testEval(input), 11)
+}
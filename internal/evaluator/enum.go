@@ -0,0 +1,240 @@
+package evaluator
+
+import (
+	"github.com/RafaLopesMelo/monkey-lang/internal/ast"
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func init() {
+	builtins["is"] = &object.Builtin{Fn: builtinIs}
+}
+
+// Binds the enum type and each of its variants, so both Circle(1) and Shape.Circle(1) build a value
+func evalEnumStatement(node *ast.EnumStatement, env *object.Environment) object.Object {
+	names := []string{node.Name.Value}
+	for _, variant := range node.Variants {
+		names = append(names, variant.Name.Value)
+	}
+
+	for _, name := range names {
+		if env.IsConstInScope(name) {
+			return newError("cannot redeclare constant: %s", name)
+		}
+	}
+
+	// Rebinding the variants of another enum in the same scope would change what their patterns
+	// match, so only redeclaring the same enum is allowed
+	for _, variant := range node.Variants {
+		existing, ok := env.GetInScope(variant.Name.Value)
+		if !ok {
+			continue
+		}
+
+		var other *object.EnumVariant
+
+		switch existing := existing.(type) {
+		case *object.EnumVariant:
+			other = existing
+		case *object.EnumValue:
+			other = existing.Variant
+		}
+
+		if other != nil && other.Name == variant.Name.Value && other.Enum.Name != node.Name.Value {
+			return newError("variant %s of enum %s would shadow the one of enum %s", other.Name, node.Name.Value, other.Enum.Name)
+		}
+	}
+
+	enum := &object.EnumType{Name: node.Name.Value}
+
+	for _, variant := range node.Variants {
+		var fields []string
+		if variant.Fields != nil {
+			fields = make([]string, len(variant.Fields))
+			for idx, field := range variant.Fields {
+				fields[idx] = field.Value
+			}
+		}
+
+		enum.Variants = append(enum.Variants, &object.EnumVariant{
+			Enum:   enum,
+			Name:   variant.Name.Value,
+			Fields: fields,
+		})
+	}
+
+	env.Set(enum.Name, enum)
+
+	for _, variant := range enum.Variants {
+		env.Set(variant.Name, enumMember(variant))
+	}
+
+	return nil
+}
+
+// Variants without fields stand for their only value, while the others are constructors
+func enumMember(variant *object.EnumVariant) object.Object {
+	if variant.IsUnit() {
+		return &object.EnumValue{Variant: variant}
+	}
+
+	return variant
+}
+
+// Builds a value from one argument per field of the variant, in declaration order
+func newEnumValue(variant *object.EnumVariant, args []object.Object) object.Object {
+	if len(args) != len(variant.Fields) {
+		return newError("wrong number of arguments. got=%d, want=%d", len(args), len(variant.Fields))
+	}
+
+	values := make([]object.Object, len(args))
+	copy(values, args)
+
+	return &object.EnumValue{Variant: variant, Values: values}
+}
+
+func evalEnumVariant(enum *object.EnumType, name string) object.Object {
+	variant, ok := enum.Variant(name)
+	if !ok {
+		return newError("unknown variant %s on enum %s", name, enum.Name)
+	}
+
+	return enumMember(variant)
+}
+
+func evalEnumField(value *object.EnumValue, field string) object.Object {
+	found, ok := value.Get(field)
+	if !ok {
+		return newError("unknown field %s on variant %s", field, value.Variant.Inspect())
+	}
+
+	return found
+}
+
+// Constructor patterns match enum values of the named variant, or structs of the named type, and
+// destructure their values by position, e.g. Rect(w, h) or Point(x, 0)
+func destructureConstructor(pattern *ast.ConstructorPattern, val object.Object, bindings []binding, env *object.Environment) ([]binding, *object.Error) {
+	constructor, err := resolveConstructor(pattern, env)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []object.Object
+	matched := false
+
+	switch constructor := constructor.(type) {
+	case *object.EnumVariant:
+		if value, ok := val.(*object.EnumValue); ok && value.Variant == constructor {
+			values, matched = value.Values, true
+		}
+	case *object.StructType:
+		if s, ok := val.(*object.Struct); ok && s.Definition == constructor {
+			values, matched = s.Values, true
+		}
+	}
+
+	if !matched {
+		return nil, newError("value %s does not match pattern %s", val.Inspect(), pattern.String())
+	}
+
+	// Without parentheses only the variant is checked, e.g. Shape.Circle matches any circle
+	if pattern.Arguments == nil {
+		return bindings, nil
+	}
+
+	if len(pattern.Arguments) != len(values) {
+		return nil, newError("pattern %s expects %d values, got %d", pattern.String(), len(pattern.Arguments), len(values))
+	}
+
+	for idx, argument := range pattern.Arguments {
+		bindings, err = destructure(argument, values[idx], bindings, env)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return bindings, nil
+}
+
+// Resolves the variant or struct type a constructor pattern names, so patterns tell apart variants
+// of different enums that share a name. Qualifiers name an enum or a module, e.g. Shape.Circle(r)
+// or geometry.Point(x, y)
+func resolveConstructor(pattern *ast.ConstructorPattern, env *object.Environment) (object.Object, *object.Error) {
+	name := pattern.Name.Value
+
+	var found object.Object
+	var ok bool
+
+	if pattern.Qualifier == nil {
+		found, ok = env.Get(name)
+	} else {
+		qualifier, _ := env.Get(pattern.Qualifier.Value)
+		name = pattern.Qualifier.Value + "." + name
+
+		switch qualifier := qualifier.(type) {
+		case *object.EnumType:
+			found, ok = qualifier.Variant(pattern.Name.Value)
+		case *object.Module:
+			found, ok = qualifier.Get(pattern.Name.Value)
+		}
+	}
+
+	if ok {
+		switch found := found.(type) {
+		case *object.EnumVariant, *object.StructType:
+			return found, nil
+		case *object.EnumValue:
+			// Variants without values are bound to their value, e.g. Empty in Empty()
+			if found.Variant.Name == pattern.Name.Value {
+				return found.Variant, nil
+			}
+		}
+	}
+
+	return nil, newError("unknown constructor %s in pattern %s", name, pattern.String())
+}
+
+// Resolves a name bound to the value of a variant without fields, such as Empty
+func unitVariant(name string, env *object.Environment) (*object.EnumVariant, bool) {
+	found, ok := env.Get(name)
+	if !ok {
+		return nil, false
+	}
+
+	value, ok := found.(*object.EnumValue)
+	if !ok || value.Variant.Name != name {
+		return nil, false
+	}
+
+	return value.Variant, true
+}
+
+// Reports whether a value belongs to a struct type, an enum or one of its variants, e.g.
+// is(shape, Shape), is(shape, Circle) or is(shape, Empty)
+func builtinIs(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	value := args[0]
+
+	switch kind := args[1].(type) {
+	case *object.StructType:
+		s, ok := value.(*object.Struct)
+		return nativeBoolToBooleanObject(ok && s.Definition == kind)
+	case *object.EnumType:
+		e, ok := value.(*object.EnumValue)
+		return nativeBoolToBooleanObject(ok && e.Variant.Enum == kind)
+	case *object.EnumVariant:
+		e, ok := value.(*object.EnumValue)
+		return nativeBoolToBooleanObject(ok && e.Variant == kind)
+	case *object.EnumValue:
+		if !kind.Variant.IsUnit() {
+			return newError("argument to `is` not supported, got %s", kind.Inspect())
+		}
+
+		e, ok := value.(*object.EnumValue)
+		return nativeBoolToBooleanObject(ok && e.Variant == kind.Variant)
+	default:
+		return newError("argument to `is` not supported, got %s", kind.Type())
+	}
+}
@@ -0,0 +1,206 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+const shapes = `enum Shape { Circle(r), Rect(w, h), Empty }; `
+
+func TestEnums(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{shapes + `Circle(2)`, "Shape.Circle(2)"},
+		{shapes + `Rect(1, [2])`, "Shape.Rect(1, [2])"},
+		{shapes + `Empty`, "Shape.Empty"},
+		{shapes + `Shape`, "enum Shape { Circle(r), Rect(w, h), Empty }"},
+		{shapes + `Circle`, "Shape.Circle(r)"},
+		{shapes + `Shape.Rect(3, 4)`, "Shape.Rect(3, 4)"},
+		{shapes + `Shape.Empty`, "Shape.Empty"},
+		{shapes + `let c = Circle(2); c.r`, "2"},
+		{shapes + `let s = Rect(3, 4); s.w * s.h`, "12"},
+		{shapes + `map([1, 2], Circle)`, "[Shape.Circle(1), Shape.Circle(2)]"},
+		{shapes + `[1, 2] |> map(Circle) |> len`, "2"},
+		{`enum Never {}; Never`, "enum Never {}"},
+		{`enum Unit { Nothing() }; Nothing()`, "Unit.Nothing()"},
+		{`let make = fn() { enum Local { One }; One }; make()`, "Local.One"},
+		{shapes + shapes + `Circle(1) == Shape.Circle(1)`, "true"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestEnumMatching(t *testing.T) {
+	area := shapes + `let area = fn(s) {
+        match (s) {
+            Circle(r) => 3 * r * r,
+            Rect(w, h) if w == h => w * w,
+            Shape.Rect(w, h) => w * h,
+            Empty => 0,
+        }
+    }; `
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{area + `area(Circle(2))`, "12"},
+		{area + `area(Rect(3, 3))`, "9"},
+		{area + `area(Rect(2, 5))`, "10"},
+		{area + `area(Empty)`, "0"},
+		{shapes + `match (Empty) { Circle(_) => 1, Shape.Empty => 2 }`, "2"},
+		{shapes + `match (Circle(1)) { Shape.Empty => 2, _ => 3 }`, "3"},
+		{shapes + `match (Rect(1, 2)) { Shape.Rect => "rect", _ => "other" }`, "rect"},
+		{shapes + `match (Rect(1, 2)) { Rect(1, h) => h, _ => 0 }`, "2"},
+		{shapes + `match (Rect(2, 2)) { Rect(1, h) => h, _ => 0 }`, "0"},
+		{shapes + `match (Circle(1)) { Rect(_, _) | Circle(_) => "round or square", _ => "none" }`, "round or square"},
+		{shapes + `match (Rect([1, 2], {"w": 3})) { Rect([a, b], {w}) => a + b + w }`, "6"},
+		{shapes + `match ([Circle(1), Empty]) { [Circle(r), Shape.Empty] => r }`, "1"},
+		{shapes + `match (Circle(1)) { Empty => "empty", Circle(r) => "circle" }`, "circle"},
+		{shapes + `match (Empty) { Empty => "empty", Circle(r) => "circle" }`, "empty"},
+		{shapes + `match (Rect(1, 2)) { Empty | Circle(_) => "round or empty", _ => "other" }`, "other"},
+		{shapes + `match ([Empty, 1]) { [Empty, n] => n }`, "1"},
+		{shapes + `let s = Empty; let s = Circle(1); s`, "Shape.Circle(1)"},
+		{shapes + `let kind = fn() { enum Kind { Circle(r) }; Circle(1) }; match (kind()) { Circle(r) => "shape", _ => "kind" }`, "kind"},
+		{shapes + `let kind = fn() { enum Kind { Circle(r) }; Circle(1) }; match (kind()) { Shape.Circle(r) => "shape", _ => "kind" }`, "kind"},
+		{shapes + `let circle = fn() { enum Kind { Circle(r) }; match (Circle(1)) { Circle(r) => "kind", _ => "shape" } }; circle()`, "kind"},
+		{shapes + `let Rect(w, h) = Rect(3, 4); w + h`, "7"},
+		{shapes + `let area = fn(Circle(r)) { r * r }; area(Circle(3))`, "9"},
+		{`struct Point { x, y }; match (Point(0, 5)) { Point(0, y) => y, _ => -1 }`, "5"},
+		{`struct Point { x, y }; match (Point(1, 5)) { Point(0, y) => y, _ => -1 }`, "-1"},
+		{shapes + `if (is(Circle(1), Circle)) { "circle" } else { "other" }`, "circle"},
+		{shapes + `filter([Circle(1), Empty, Rect(1, 2)], fn(s) { !is(s, Empty) })`, "[Shape.Circle(1), Shape.Rect(1, 2)]"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestExportEnum(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"main.monkey": `
+            import "option" as option;
+            let unwrap = fn(o) { match (o) { option.Some(v) => v, option.None => 0 } };
+            [option.Some(1), option.None, unwrap(option.Some(5)), unwrap(option.None), option.Option.Some(2) == option.Some(2)];
+        `,
+		"option.monkey": `
+            export enum Option { Some(value), None }
+        `,
+	})
+
+	evaluated := testEvalModule(t, dir, "main.monkey")
+
+	if evaluated.Inspect() != "[Option.Some(1), Option.None, 5, 0, true]" {
+		t.Errorf("wrong result. got=%q", evaluated.Inspect())
+	}
+}
+
+func TestEnumEquality(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{shapes + `Circle(1) == Circle(1)`, true},
+		{shapes + `Circle(1) == Circle(1.0)`, true},
+		{shapes + `Circle(1) == Circle(2)`, false},
+		{shapes + `Rect(1, [2]) == Rect(1, [2])`, true},
+		{shapes + `Empty == Shape.Empty`, true},
+		{shapes + `Empty == Circle(1)`, false},
+		{shapes + `let other = fn() { enum Other { Empty }; Empty }; Shape.Empty == other()`, false},
+		{shapes + `same(Circle(1), Circle(1))`, true},
+		{shapes + `Circle == Shape.Circle`, true},
+		{shapes + `is(Circle(1), Shape)`, true},
+		{shapes + `is(Circle(1), Rect)`, false},
+		{shapes + `is(Empty, Empty)`, true},
+		{shapes + `is(1, Shape)`, false},
+		{shapes + `struct Point { x, y }; is(Point(1, 2), Point)`, true},
+		{shapes + `struct Point { x, y }; is(Circle(1), Point)`, false},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+}
+
+func TestEnumHashKeys(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{shapes + `let h = {Circle(1): "small", Empty: "none"}; [h[Circle(1)], h[Empty], h[Circle(2)]]`, "[small, none, null]"},
+		{shapes + `let h = {Rect(1, 2): 1}; h[Rect(2, 1)]`, "null"},
+		{shapes + `let h = {Rect(freeze([1]), 2): "frozen"}; h[Rect(freeze([1]), 2)]`, "frozen"},
+		{shapes + `{Circle([1]): 1}`, "ERROR: unusable as hash key: ENUM"},
+		{shapes + `let c = freeze(Circle([1])); {c: 1}[Circle(freeze([1]))]`, "1"},
+		{shapes + `group_by([Circle(1), Empty, Circle(1)], fn(s) { s })`, "{Shape.Circle(1): [Shape.Circle(1), Shape.Circle(1)], Shape.Empty: [Shape.Empty]}"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestEnumErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{shapes + `Circle()`, "wrong number of arguments. got=0, want=1"},
+		{shapes + `Rect(1, 2, 3)`, "wrong number of arguments. got=3, want=2"},
+		{shapes + `Empty()`, "not a function: ENUM"},
+		{shapes + `Shape.Triangle`, "unknown variant Triangle on enum Shape"},
+		{shapes + `Circle(1).w`, "unknown field w on variant Shape.Circle(r)"},
+		{shapes + `Empty.r`, "unknown field r on variant Shape.Empty"},
+		{shapes + `let c = Circle(1); c.r = 2`, "member assignment not supported: ENUM"},
+		{shapes + `Circle(1)[0]`, "index operator not supported: ENUM"},
+		{shapes + `let Circle(r) = Empty`, "value Shape.Empty does not match pattern Circle(r)"},
+		{shapes + `let Rect(w) = Rect(1, 2)`, "pattern Rect(w) expects 1 values, got 2"},
+		{shapes + `let Circle(r) = 1`, "value 1 does not match pattern Circle(r)"},
+		{shapes + `let Empty = Circle(1)`, "value Shape.Circle(1) does not match pattern Empty"},
+		{shapes + `let Triangle(a) = Circle(1)`, "unknown constructor Triangle in pattern Triangle(a)"},
+		{shapes + `let Shape.Triangle(a) = Circle(1)`, "unknown constructor Shape.Triangle in pattern Shape.Triangle(a)"},
+		{shapes + `let x = 1; let x(a) = 1`, "unknown constructor x in pattern x(a)"},
+		{shapes + `enum Other { Circle(r) }`, "variant Circle of enum Other would shadow the one of enum Shape"},
+		{shapes + `enum Other { Empty }`, "variant Empty of enum Other would shadow the one of enum Shape"},
+		{shapes + `match (Circle(1)) { Rect(w, h) => w }`, "no match arm for value: Shape.Circle(1)"},
+		{`match (3) { Foo(x) => 1, _ => 2 }`, "unknown constructor Foo in pattern Foo(x)"},
+		{shapes + `match (3) { 3 => 1, [Shape.Triangle] | _ => 2 }`, "unknown constructor Shape.Triangle in pattern Shape.Triangle"},
+		{shapes + `is(Circle(1), Circle(1))`, "argument to `is` not supported, got Shape.Circle(1)"},
+		{shapes + `is(Circle(1), 1)`, "argument to `is` not supported, got INTEGER"},
+		{`const Empty = 1; enum Shape { Empty }`, "cannot redeclare constant: Empty"},
+		{shapes + `json_encode(Empty)`, "cannot encode ENUM as JSON"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
@@ -2,6 +2,9 @@ package evaluator
 
 import (
 	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/RafaLopesMelo/monkey-lang/internal/ast"
 	"github.com/RafaLopesMelo/monkey-lang/internal/object"
@@ -23,9 +26,11 @@ var builtins = map[string]*object.Builtin{
 
 			switch arg := args[0].(type) {
 			case *object.String:
-				return &object.Integer{Value: int64(len(arg.Value))}
+				return &object.Integer{Value: int64(utf8.RuneCountInString(arg.Value))}
 			case *object.Array:
 				return &object.Integer{Value: int64(len(arg.Elements))}
+			case *object.Range:
+				return &object.Integer{Value: arg.Len()}
 			default:
 				return newError("argument to `len` not supported, got %s", arg.Type())
 			}
@@ -37,6 +42,14 @@ var builtins = map[string]*object.Builtin{
 				return newError("wrong number of arguments. got=%d, want=1", len(args))
 			}
 
+			if r, ok := args[0].(*object.Range); ok {
+				if r.Len() == 0 {
+					return NULL
+				}
+
+				return &object.Integer{Value: r.Start}
+			}
+
 			if args[0].Type() != object.ARRAY_OBJ {
 				return newError("argument to `first` not supported, got %s", args[0].Type())
 			}
@@ -55,6 +68,14 @@ var builtins = map[string]*object.Builtin{
 				return newError("wrong number of arguments. got=%d, want=1", len(args))
 			}
 
+			if r, ok := args[0].(*object.Range); ok {
+				if r.Len() == 0 {
+					return NULL
+				}
+
+				return &object.Integer{Value: r.At(r.Len() - 1)}
+			}
+
 			if args[0].Type() != object.ARRAY_OBJ {
 				return newError("argument to `last` not supported, got %s", args[0].Type())
 			}
@@ -74,6 +95,15 @@ var builtins = map[string]*object.Builtin{
 				return newError("wrong number of arguments. got=%d, want=1", len(args))
 			}
 
+			// The rest of a range is still a range, so walking one with first and rest stays lazy
+			if r, ok := args[0].(*object.Range); ok {
+				if r.Len() == 0 {
+					return NULL
+				}
+
+				return r.Slice(1, r.Len())
+			}
+
 			if args[0].Type() != object.ARRAY_OBJ {
 				return newError("argument to `rest` not supported, got %s", args[0].Type())
 			}
@@ -110,6 +140,35 @@ var builtins = map[string]*object.Builtin{
 			return &object.Array{Elements: newArray}
 		},
 	},
+	"freeze": {
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 1 {
+				return newError("wrong number of arguments. got=%d, want=1", len(args))
+			}
+
+			if f, ok := args[0].(object.Freezable); ok {
+				f.Freeze()
+			}
+
+			return args[0]
+		},
+	},
+	// Reference equality: arrays and hashes are only the same as themselves, while values such as
+	// numbers and strings, which can't be mutated, are the same when they are equal
+	"same": {
+		Fn: func(args ...object.Object) object.Object {
+			if len(args) != 2 {
+				return newError("wrong number of arguments. got=%d, want=2", len(args))
+			}
+
+			switch args[0].(type) {
+			case *object.Array, *object.Hash, *object.Struct:
+				return nativeBoolToBooleanObject(args[0] == args[1])
+			default:
+				return nativeBoolToBooleanObject(object.Equal(args[0], args[1]))
+			}
+		},
+	},
 	"puts": {
 		Fn: func(args ...object.Object) object.Object {
 			for _, arg := range args {
@@ -121,6 +180,13 @@ var builtins = map[string]*object.Builtin{
 	},
 }
 
+// When set, indexing or slicing arrays and strings out of their bounds is an error instead of
+// returning null or clamping the slice
+var Strict bool
+
+// Builtins grouped under a name and exposed as frozen hashes, e.g. math.sqrt
+var namespaces = map[string]*object.Hash{}
+
 func Eval(node ast.Node, env *object.Environment) object.Object {
 	switch node := node.(type) {
 	case *ast.Program:
@@ -129,10 +195,14 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 		return Eval(node.Expression, env)
 	case *ast.IntegerLiteral:
 		return &object.Integer{Value: node.Value}
+	case *ast.FloatLiteral:
+		return &object.Float{Value: node.Value}
 	case *ast.StringLiteral:
 		return &object.String{Value: node.Value}
 	case *ast.Boolean:
 		return nativeBoolToBooleanObject(node.Value)
+	case *ast.NullLiteral:
+		return NULL
 	case *ast.PrefixExpression:
 		right := Eval(node.Right, env)
 
@@ -148,6 +218,15 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 			return left
 		}
 
+		// The right side of ?? is only evaluated when it's needed
+		if node.Operator == "??" {
+			if left != NULL {
+				return left
+			}
+
+			return Eval(node.Right, env)
+		}
+
 		right := Eval(node.Right, env)
 
 		if isError(right) {
@@ -159,6 +238,14 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 		return evalBlockStatements(node, env)
 	case *ast.IfExpression:
 		return evalIfExpression(node, env)
+	case *ast.TernaryExpression:
+		return evalTernaryExpression(node, env)
+	case *ast.MatchExpression:
+		return evalMatchExpression(node, env)
+	case *ast.ThrowExpression:
+		return evalThrowExpression(node, env)
+	case *ast.TryExpression:
+		return evalTryExpression(node, env)
 	case *ast.ReturnStatement:
 		val := Eval(node.ReturnValue, env)
 
@@ -174,7 +261,34 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 			return val
 		}
 
-		env.Set(node.Name.Value, val)
+		bindings, err := destructure(node.Name, val, nil, env)
+		if err != nil {
+			return err
+		}
+
+		for _, b := range bindings {
+			if env.IsConstInScope(b.name) {
+				return newError("cannot redeclare constant: %s", b.name)
+			}
+		}
+
+		for _, b := range bindings {
+			if node.IsConst() {
+				env.SetConst(b.name, b.value)
+			} else {
+				env.Set(b.name, b.value)
+			}
+		}
+	case *ast.ImportStatement:
+		return evalImportStatement(node, env)
+	case *ast.ExportStatement:
+		return Eval(node.Statement, env)
+	case *ast.StructStatement:
+		return evalStructStatement(node, env)
+	case *ast.EnumStatement:
+		return evalEnumStatement(node, env)
+	case *ast.AssignExpression:
+		return evalAssignExpression(node, env)
 	case *ast.Identifier:
 		return evalIdentifier(node, env)
 	case *ast.FunctionLiteral:
@@ -186,20 +300,9 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 			Body:       body,
 			Env:        env,
 		}
-	case *ast.CallExpression:
-		fn := Eval(node.Function, env)
-
-		if isError(fn) {
-			return fn
-		}
-
-		args := evalExpressions(node.Arguments, env)
-
-		if len(args) == 1 && isError(args[0]) {
-			return args[0]
-		}
-
-		return applyFunction(fn, args)
+	case *ast.CallExpression, *ast.IndexExpression, *ast.MemberExpression:
+		result, _ := evalChain(node.(ast.Expression), env)
+		return result
 	case *ast.ArrayLiteral:
 		elements := evalExpressions(node.Elements, env)
 		if len(elements) == 1 && isError(elements[0]) {
@@ -207,18 +310,12 @@ func Eval(node ast.Node, env *object.Environment) object.Object {
 		}
 
 		return &object.Array{Elements: elements}
-	case *ast.IndexExpression:
-		left := Eval(node.Left, env)
-		if isError(left) {
-			return left
-		}
-
-		index := Eval(node.Index, env)
-		if isError(index) {
-			return index
-		}
-
-		return evalIndexExpression(left, index)
+	case *ast.SliceExpression:
+		return evalSliceExpression(node, env)
+	case *ast.RangeExpression:
+		return evalRangeExpression(node, env)
+	case *ast.PipeExpression:
+		return evalPipeExpression(node, env)
 	case *ast.HashLiteral:
 		return evalHashLiteral(node, env)
 	}
@@ -280,27 +377,56 @@ func evalBlockStatements(block *ast.BlockStatement, env *object.Environment) obj
 func applyFunction(fn object.Object, args []object.Object) object.Object {
 	switch function := fn.(type) {
 	case *object.Function:
-		env := extendFunctionEnv(function, args)
+		env, err := extendFunctionEnv(function, args)
+		if err != nil {
+			return err
+		}
+
 		evaluated := Eval(function.Body, env)
 
+		// Empty bodies, or bodies ending in a let, produce no value at all
+		if evaluated == nil {
+			return NULL
+		}
+
 		return unwrapReturnValue(evaluated)
 
 	case *object.Builtin:
 		return function.Fn(args...)
 
+	case *object.StructType:
+		return newStruct(function, args)
+
+	case *object.EnumVariant:
+		return newEnumValue(function, args)
+
 	default:
 		return newError("not a function: %s", fn.Type())
 	}
 }
 
-func extendFunctionEnv(fn *object.Function, args []object.Object) *object.Environment {
+func extendFunctionEnv(fn *object.Function, args []object.Object) (*object.Environment, *object.Error) {
+	if len(args) < len(fn.Parameters) {
+		return nil, newError("wrong number of arguments. got=%d, want=%d", len(args), len(fn.Parameters))
+	}
+
 	env := object.NewEnclosedEnvironment(fn.Env)
 
+	var bindings []binding
+	var err *object.Error
+
 	for idx, param := range fn.Parameters {
-		env.Set(param.Value, args[idx])
+		bindings, err = destructure(param, args[idx], bindings, env)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, b := range bindings {
+		env.Set(b.name, b.value)
 	}
 
-	return env
+	return env, nil
 }
 
 func unwrapReturnValue(obj object.Object) object.Object {
@@ -344,24 +470,28 @@ func evalBangOperatorExpression(right object.Object) object.Object {
 }
 
 func evalMinusPrefixOperatorExpression(right object.Object) object.Object {
-	if right.Type() != object.INTEGER_OBJ {
+	switch right := right.(type) {
+	case *object.Integer:
+		return &object.Integer{Value: -right.Value}
+	case *object.Float:
+		return &object.Float{Value: -right.Value}
+	default:
 		return newError("unknown operator: -%s", right.Type())
 	}
-
-	value := right.(*object.Integer).Value
-	return &object.Integer{Value: -value}
 }
 
 func evalInfixExpression(operator string, left object.Object, right object.Object) object.Object {
 	switch {
 	case left.Type() == object.INTEGER_OBJ && right.Type() == object.INTEGER_OBJ:
 		return evalIntegerInfixExpression(operator, left, right)
+	case isNumber(left) && isNumber(right):
+		return evalFloatInfixExpression(operator, left, right)
 	case left.Type() == object.STRING_OBJ && right.Type() == object.STRING_OBJ:
 		return evalStringInfixExpression(operator, left, right)
 	case operator == "==":
-		return nativeBoolToBooleanObject(left == right)
+		return nativeBoolToBooleanObject(object.Equal(left, right))
 	case operator == "!=":
-		return nativeBoolToBooleanObject(left != right)
+		return nativeBoolToBooleanObject(!object.Equal(left, right))
 	case left.Type() != right.Type():
 		return newError("type mismatch: %s %s %s", left.Type(), operator, right.Type())
 	default:
@@ -381,7 +511,54 @@ func evalIntegerInfixExpression(operator string, left object.Object, right objec
 	case "*":
 		return &object.Integer{Value: leftVal * rightVal}
 	case "/":
+		if rightVal == 0 {
+			return newError("division by zero: %d / %d", leftVal, rightVal)
+		}
+
 		return &object.Integer{Value: leftVal / rightVal}
+	case "%":
+		if rightVal == 0 {
+			return newError("division by zero: %d %% %d", leftVal, rightVal)
+		}
+
+		return &object.Integer{Value: leftVal % rightVal}
+	case "<":
+		return nativeBoolToBooleanObject(leftVal < rightVal)
+	case ">":
+		return nativeBoolToBooleanObject(leftVal > rightVal)
+	case "==":
+		return nativeBoolToBooleanObject(leftVal == rightVal)
+	case "!=":
+		return nativeBoolToBooleanObject(leftVal != rightVal)
+	default:
+		return newError("unknown operator: %s %s %s", left.Type(), operator, right.Type())
+	}
+}
+
+// Evaluates arithmetic involving at least one float, converting the other operand to a float as well
+func evalFloatInfixExpression(operator string, left object.Object, right object.Object) object.Object {
+	leftVal, _ := toFloat(left)
+	rightVal, _ := toFloat(right)
+
+	switch operator {
+	case "+":
+		return &object.Float{Value: leftVal + rightVal}
+	case "-":
+		return &object.Float{Value: leftVal - rightVal}
+	case "*":
+		return &object.Float{Value: leftVal * rightVal}
+	case "/":
+		if rightVal == 0 {
+			return newError("division by zero: %s / %s", left.Inspect(), right.Inspect())
+		}
+
+		return &object.Float{Value: leftVal / rightVal}
+	case "%":
+		if rightVal == 0 {
+			return newError("division by zero: %s %% %s", left.Inspect(), right.Inspect())
+		}
+
+		return &object.Float{Value: math.Mod(leftVal, rightVal)}
 	case "<":
 		return nativeBoolToBooleanObject(leftVal < rightVal)
 	case ">":
@@ -395,6 +572,21 @@ func evalIntegerInfixExpression(operator string, left object.Object, right objec
 	}
 }
 
+func isNumber(obj object.Object) bool {
+	return obj.Type() == object.INTEGER_OBJ || obj.Type() == object.FLOAT_OBJ
+}
+
+func toFloat(obj object.Object) (float64, bool) {
+	switch obj := obj.(type) {
+	case *object.Integer:
+		return float64(obj.Value), true
+	case *object.Float:
+		return obj.Value, true
+	default:
+		return 0, false
+	}
+}
+
 func evalStringInfixExpression(operator string, left object.Object, right object.Object) object.Object {
 	leftVal := left.(*object.String).Value
 	rightVal := right.(*object.String).Value
@@ -418,15 +610,85 @@ func evalIfExpression(node *ast.IfExpression, env *object.Environment) object.Ob
 		return condition
 	}
 
+	var result object.Object = NULL
+
+	if isTruthy(condition) {
+		result = Eval(node.Consequence, env)
+	} else if node.Alternative != nil {
+		result = Eval(node.Alternative, env)
+	}
+
+	// Empty branches, or branches ending in a let, produce no value at all
+	if result == nil {
+		return NULL
+	}
+
+	return result
+}
+
+func evalTernaryExpression(node *ast.TernaryExpression, env *object.Environment) object.Object {
+	condition := Eval(node.Condition, env)
+
+	if isError(condition) {
+		return condition
+	}
+
 	if isTruthy(condition) {
 		return Eval(node.Consequence, env)
 	}
 
-	if node.Alternative != nil {
-		return Eval(node.Alternative, env)
+	return Eval(node.Alternative, env)
+}
+
+// Evaluates the body of the first arm whose pattern matches the subject and whose guard, if any,
+// is truthy. Each arm binds its pattern names in a scope of its own.
+func evalMatchExpression(node *ast.MatchExpression, env *object.Environment) object.Object {
+	subject := Eval(node.Subject, env)
+
+	if isError(subject) {
+		return subject
+	}
+
+	for _, arm := range node.Arms {
+		if err := resolvePatterns(arm.Pattern, env); err != nil {
+			return err
+		}
+	}
+
+	for _, arm := range node.Arms {
+		bindings, err := destructure(arm.Pattern, subject, nil, env)
+		if err != nil {
+			continue
+		}
+
+		armEnv := object.NewEnclosedEnvironment(env)
+		for _, b := range bindings {
+			armEnv.Set(b.name, b.value)
+		}
+
+		if arm.Guard != nil {
+			guard := Eval(arm.Guard, armEnv)
+
+			if isError(guard) {
+				return guard
+			}
+
+			if !isTruthy(guard) {
+				continue
+			}
+		}
+
+		result := Eval(arm.Body, armEnv)
+
+		// Empty arm bodies, or bodies ending in a let, produce no value at all
+		if result == nil {
+			return NULL
+		}
+
+		return result
 	}
 
-	return NULL
+	return newError("no match arm for value: %s", subject.Inspect())
 }
 
 func evalIdentifier(node *ast.Identifier, env *object.Environment) object.Object {
@@ -438,13 +700,173 @@ func evalIdentifier(node *ast.Identifier, env *object.Environment) object.Object
 		return builtin
 	}
 
+	if namespace, ok := namespaces[node.Value]; ok {
+		return namespace
+	}
+
 	return newError("identifier not found: %s", node.Value)
 }
 
+func evalAssignExpression(node *ast.AssignExpression, env *object.Environment) object.Object {
+	switch target := node.Target.(type) {
+	case *ast.Identifier:
+		return evalIdentifierAssignment(target, node, env)
+	case *ast.IndexExpression:
+		left := Eval(target.Left, env)
+		if isError(left) {
+			return left
+		}
+
+		index := Eval(target.Index, env)
+		if isError(index) {
+			return index
+		}
+
+		return evalIndexAssignment(left, index, node, env)
+	case *ast.MemberExpression:
+		obj := Eval(target.Object, env)
+		if isError(obj) {
+			return obj
+		}
+
+		switch obj.Type() {
+		case object.HASH_OBJ:
+			key := &object.String{Value: target.Property.Value}
+
+			return evalHashIndexAssignment(obj, key, node, env)
+		case object.STRUCT_OBJ:
+			return evalStructFieldAssignment(obj.(*object.Struct), target.Property.Value, node, env)
+		case object.STRUCT_TYPE_OBJ:
+			return evalStructMethodAssignment(obj.(*object.StructType), target.Property.Value, node, env)
+		default:
+			return newError("member assignment not supported: %s", obj.Type())
+		}
+	default:
+		return newError("invalid assignment target: %s", node.Target.String())
+	}
+}
+
+func evalIdentifierAssignment(target *ast.Identifier, node *ast.AssignExpression, env *object.Environment) object.Object {
+	current, ok := env.Get(target.Value)
+	if !ok {
+		return newError("identifier not found: %s", target.Value)
+	}
+
+	if env.IsConst(target.Value) {
+		return newError("cannot assign to constant: %s", target.Value)
+	}
+
+	val := Eval(node.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	val = evalCompoundOperator(node.Operator, current, val)
+	if isError(val) {
+		return val
+	}
+
+	if _, ok := env.Assign(target.Value, val); !ok {
+		return newError("identifier not found: %s", target.Value)
+	}
+
+	return val
+}
+
+func evalIndexAssignment(left object.Object, index object.Object, node *ast.AssignExpression, env *object.Environment) object.Object {
+	switch {
+	case left.Type() == object.ARRAY_OBJ && index.Type() == object.INTEGER_OBJ:
+		return evalArrayIndexAssignment(left, index, node, env)
+	case left.Type() == object.HASH_OBJ:
+		return evalHashIndexAssignment(left, index, node, env)
+	default:
+		return newError("index assignment not supported: %s", left.Type())
+	}
+}
+
+func evalArrayIndexAssignment(left object.Object, index object.Object, node *ast.AssignExpression, env *object.Environment) object.Object {
+	array := left.(*object.Array)
+	idx := index.(*object.Integer).Value
+
+	if array.Frozen {
+		return newError("cannot mutate frozen %s", array.Type())
+	}
+
+	length := int64(len(array.Elements))
+
+	if idx < 0 {
+		idx += length
+	}
+
+	if idx < 0 || idx >= length {
+		return newError("index out of range: %d (length %d)", index.(*object.Integer).Value, length)
+	}
+
+	val := Eval(node.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	val = evalCompoundOperator(node.Operator, array.Elements[idx], val)
+	if isError(val) {
+		return val
+	}
+
+	array.Elements[idx] = val
+
+	return val
+}
+
+func evalHashIndexAssignment(hash object.Object, index object.Object, node *ast.AssignExpression, env *object.Environment) object.Object {
+	hashObject := hash.(*object.Hash)
+
+	if hashObject.Frozen {
+		return newError("cannot mutate frozen %s", hashObject.Type())
+	}
+
+	key, ok := object.AsHashable(index)
+	if !ok {
+		return newError("unusable as hash key: %s", index.Type())
+	}
+
+	var current object.Object = NULL
+	if value, ok := hashObject.Get(key); ok {
+		current = value
+	}
+
+	val := Eval(node.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	val = evalCompoundOperator(node.Operator, current, val)
+	if isError(val) {
+		return val
+	}
+
+	hashObject.Set(key, val)
+
+	return val
+}
+
+// Resolves the value produced by compound operators such as "+=", which apply the infix operator
+// to the current and the new value. Plain "=" just yields the new value.
+func evalCompoundOperator(operator string, current object.Object, val object.Object) object.Object {
+	if operator == "=" {
+		return val
+	}
+
+	return evalInfixExpression(strings.TrimSuffix(operator, "="), current, val)
+}
+
 func evalIndexExpression(left object.Object, index object.Object) object.Object {
 	switch {
 	case left.Type() == object.ARRAY_OBJ && index.Type() == object.INTEGER_OBJ:
 		return evalArrayIndexExpression(left, index)
+	case left.Type() == object.STRING_OBJ && index.Type() == object.INTEGER_OBJ:
+		return evalStringIndexExpression(left, index)
+	case left.Type() == object.RANGE_OBJ && index.Type() == object.INTEGER_OBJ:
+		return evalRangeIndexExpression(left, index)
 	case left.Type() == object.HASH_OBJ:
 		return evalHashIndexExpression(left, index)
 	default:
@@ -454,59 +876,227 @@ func evalIndexExpression(left object.Object, index object.Object) object.Object
 
 func evalArrayIndexExpression(left object.Object, index object.Object) object.Object {
 	array := left.(*object.Array)
-	idx := index.(*object.Integer).Value
+	idx, err := resolveIndex(index.(*object.Integer).Value, int64(len(array.Elements)))
 
-	max := int64(len(array.Elements) - 1)
+	if err != nil {
+		return err
+	}
 
-	if idx < 0 || idx > max {
+	if idx == -1 {
 		return NULL
 	}
 
 	return array.Elements[idx]
 }
 
+// Indexes the characters of the string rather than its bytes, returning a one character string
+func evalStringIndexExpression(left object.Object, index object.Object) object.Object {
+	runes := []rune(left.(*object.String).Value)
+	idx, err := resolveIndex(index.(*object.Integer).Value, int64(len(runes)))
+
+	if err != nil {
+		return err
+	}
+
+	if idx == -1 {
+		return NULL
+	}
+
+	return &object.String{Value: string(runes[idx])}
+}
+
+// Computes the element at the index instead of producing the range, e.g. (0..10 step 2)[-1] is 8
+func evalRangeIndexExpression(left object.Object, index object.Object) object.Object {
+	r := left.(*object.Range)
+	idx, err := resolveIndex(index.(*object.Integer).Value, r.Len())
+
+	if err != nil {
+		return err
+	}
+
+	if idx == -1 {
+		return NULL
+	}
+
+	return &object.Integer{Value: r.At(idx)}
+}
+
+// Turns a negative index into one counting from the end. Returns -1 for indexes out of range, or
+// an error in strict mode.
+func resolveIndex(idx int64, length int64) (int64, *object.Error) {
+	resolved := idx
+	if resolved < 0 {
+		resolved += length
+	}
+
+	if resolved < 0 || resolved >= length {
+		if Strict {
+			return 0, newError("index out of range: %d (length %d)", idx, length)
+		}
+
+		return -1, nil
+	}
+
+	return resolved, nil
+}
+
+func evalSliceExpression(node *ast.SliceExpression, env *object.Environment) object.Object {
+	left := Eval(node.Left, env)
+	if isError(left) {
+		return left
+	}
+
+	var length int64
+
+	switch left := left.(type) {
+	case *object.Array:
+		length = int64(len(left.Elements))
+	case *object.String:
+		length = int64(utf8.RuneCountInString(left.Value))
+	case *object.Range:
+		length = left.Len()
+	default:
+		return newError("slice operator not supported: %s", left.Type())
+	}
+
+	bounds := []int64{0, length}
+
+	for idx, boundNode := range []ast.Expression{node.Start, node.End} {
+		if boundNode == nil {
+			continue
+		}
+
+		bound := Eval(boundNode, env)
+		if isError(bound) {
+			return bound
+		}
+
+		integer, ok := bound.(*object.Integer)
+		if !ok {
+			return newError("slice index must be INTEGER, got %s", bound.Type())
+		}
+
+		if Strict && (integer.Value < -length || integer.Value > length) {
+			return newError("slice index out of range: %d (length %d)", integer.Value, length)
+		}
+
+		bounds[idx] = clampPosition(integer.Value, length)
+	}
+
+	start, end := bounds[0], max(bounds[0], bounds[1])
+
+	switch left := left.(type) {
+	case *object.String:
+		return &object.String{Value: string([]rune(left.Value)[start:end])}
+	case *object.Range:
+		return left.Slice(start, end)
+	}
+
+	elements := make([]object.Object, end-start)
+	copy(elements, left.(*object.Array).Elements[start:end])
+
+	return &object.Array{Elements: elements}
+}
+
+func evalRangeExpression(node *ast.RangeExpression, env *object.Environment) object.Object {
+	bounds := []int64{0, 0, 1}
+
+	for idx, boundNode := range []ast.Expression{node.Start, node.End, node.Step} {
+		if boundNode == nil {
+			continue
+		}
+
+		bound := Eval(boundNode, env)
+		if isError(bound) {
+			return bound
+		}
+
+		integer, ok := bound.(*object.Integer)
+		if !ok {
+			return newError("range bounds must be INTEGER, got %s", bound.Type())
+		}
+
+		bounds[idx] = integer.Value
+	}
+
+	if bounds[2] == 0 {
+		return newError("range step must not be zero")
+	}
+
+	r := &object.Range{Start: bounds[0], End: bounds[1], Step: bounds[2], Inclusive: node.Inclusive}
+
+	if r.Overflows() {
+		return newError("range %s has too many elements", r.Inspect())
+	}
+
+	return r
+}
+
 func evalHashLiteral(node *ast.HashLiteral, env *object.Environment) object.Object {
-	pairs := make(map[object.HashKey]object.HashPair)
+	hash := object.NewHash()
 
-	for keyNode, valueNode := range node.Pairs {
-		key := Eval(keyNode, env)
+	for _, pair := range node.Pairs {
+		key := Eval(pair.Key, env)
 		if isError(key) {
 			return key
 		}
 
-		hashKey, ok := key.(object.Hashable)
+		hashKey, ok := object.AsHashable(key)
 		if !ok {
 			return newError("unusable as hash key: %s", key.Type())
 		}
 
-		value := Eval(valueNode, env)
+		value := Eval(pair.Value, env)
 		if isError(value) {
 			return value
 		}
 
-		hashed := hashKey.HashKey()
-		pairs[hashed] = object.HashPair{Key: key, Value: value}
-
+		hash.Set(hashKey, value)
 	}
 
-	return &object.Hash{Pairs: pairs}
+	return hash
 }
 
 func evalHashIndexExpression(hash object.Object, index object.Object) object.Object {
 	hashObject := hash.(*object.Hash)
 
-	key, ok := index.(object.Hashable)
+	key, ok := object.AsHashable(index)
 	if !ok {
 		return newError("unusable as hash key: %s", index.Type())
 	}
 
-	pair, ok := hashObject.Pairs[key.HashKey()]
+	value, ok := hashObject.Lookup(key)
 
 	if !ok {
 		return NULL
 	}
 
-	return pair.Value
+	return value
+}
+
+func evalMemberExpression(obj object.Object, property *ast.Identifier) object.Object {
+	switch obj.Type() {
+	case object.HASH_OBJ:
+		return evalHashIndexExpression(obj, &object.String{Value: property.Value})
+	case object.STRUCT_OBJ:
+		return evalStructField(obj.(*object.Struct), property.Value)
+	case object.STRUCT_TYPE_OBJ:
+		return evalStructMethod(obj.(*object.StructType), property.Value)
+	case object.ENUM_TYPE_OBJ:
+		return evalEnumVariant(obj.(*object.EnumType), property.Value)
+	case object.ENUM_OBJ:
+		return evalEnumField(obj.(*object.EnumValue), property.Value)
+	case object.MODULE_OBJ:
+		module := obj.(*object.Module)
+
+		if val, ok := module.Get(property.Value); ok {
+			return val
+		}
+
+		return newError("module %s does not export %s", module.Path, property.Value)
+	default:
+		return newError("member access not supported: %s", obj.Type())
+	}
 }
 
 func isTruthy(obj object.Object) bool {
@@ -523,7 +1113,7 @@ func isTruthy(obj object.Object) bool {
 }
 
 func newError(format string, a ...any) *object.Error {
-	return &object.Error{Message: fmt.Sprintf(format, a...)}
+	return &object.Error{Message: fmt.Sprintf(format, a...), Kind: object.RUNTIME_ERROR}
 }
 
 func isError(obj object.Object) bool {
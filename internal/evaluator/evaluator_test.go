@@ -28,6 +28,8 @@ func TestEvalIntegerExpression(t *testing.T) {
 		{"3 * 3 * 3 + 10", 37},
 		{"3 * (3 * 3) + 10", 37},
 		{"(5 + 10 * 2 + 15 / 3) * 2 + -10", 50},
+		{"10 % 3", 1},
+		{"-10 % 3", -1},
 	}
 
 	for _, tt := range tests {
@@ -99,6 +101,63 @@ func TestEvalBooleanExpression(t *testing.T) {
 	}
 }
 
+func TestStructuralEquality(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"[1, 2] == [1, 2]", true},
+		{"[1, 2] != [1, 2]", false},
+		{"[1, 2] == [2, 1]", false},
+		{"[1, 2] == [1, 2, 3]", false},
+		{"[] == []", true},
+		{`[1, "a", true, [2.0]] == [1, "a", true, [2]]`, true},
+		{`{"a": 1, "b": [2]} == {"b": [2], "a": 1}`, true},
+		{`{"a": 1} == {"a": 2}`, false},
+		{`{"a": 1} == {"b": 1}`, false},
+		{`{"a": 1} == {"a": 1, "b": 2}`, false},
+		{"{} == {}", true},
+		{"[1] == {}", false},
+		{"[1] == 1", false},
+		{`"1" == 1`, false},
+		{"let f = fn() {}; [f] == [f]", true},
+		{"[fn() {}] == [fn() {}]", false},
+		{"[len] == [len]", true},
+		{"[if (false) { 1 }] == [if (false) { 2 }]", true},
+		{"let a = [1]; a[0] = a; let b = [1]; b[0] = b; a == b", true},
+		{`let a = {}; a["self"] = a; let b = {}; b["self"] = b; a == b`, true},
+		{`let a = {}; a["self"] = a; let b = {}; b["self"] = 1; a == b`, false},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+}
+
+func TestSameFunction(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"same([1], [1])", false},
+		{"let a = [1]; same(a, a)", true},
+		{"let a = [1]; let b = a; b[0] = 2; same(a, b)", true},
+		{"same({}, {})", false},
+		{"let h = {}; same(h, h)", true},
+		{"same(1, 1)", true},
+		{`same("a", "a")`, true},
+		{"same(1, 2)", false},
+		{"same(fn() {}, fn() {})", false},
+		{"let f = fn() {}; same(f, f)", true},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+}
+
 func TestBangOperator(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -195,6 +254,8 @@ func TestErrorHandling(t *testing.T) {
 		{"foobar", "identifier not found: foobar"},
 		{`"Hello" - "World"`, "unknown operator: STRING - STRING"},
 		{`{"name": "Monkey"}[fn(x) { x }];`, "unusable as hash key: FUNCTION"},
+		{"10 / 0", "division by zero: 10 / 0"},
+		{"10 % 0", "division by zero: 10 % 0"},
 	}
 
 	for _, tt := range tests {
@@ -228,6 +289,377 @@ func TestLetStatement(t *testing.T) {
 	}
 }
 
+func TestAssignExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"let a = 5; a = 10; a;", 10},
+		{"let a = 5; a = 10;", 10},
+		{"let a = 5; let b = 0; a = b = 3; a + b;", 6},
+		{"let a = 5; a += 2; a;", 7},
+		{"let a = 5; a -= 2; a;", 3},
+		{"let a = 5; a *= 2; a;", 10},
+		{"let a = 5; a /= 2; a;", 2},
+		{"let a = 5; a %= 2; a;", 1},
+		{"let a = 1; let f = fn() { a = 2 }; f(); a;", 2},
+		{"let a = 1; let f = fn() { let a = 5; a = 2 }; f(); a;", 1},
+		{"let a = 1; if (true) { a += 1 }; a;", 2},
+	}
+
+	for _, tt := range tests {
+		testIntegerObject(t, testEval(tt.input), tt.expected)
+	}
+}
+
+func TestAssignExpressionErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{"a = 5;", "identifier not found: a"},
+		{"let f = fn() { b += 1 }; f();", "identifier not found: b"},
+		{"let a = 5; a += true;", "type mismatch: INTEGER + BOOLEAN"},
+		{"let a = 5; a /= 0;", "division by zero: 5 / 0"},
+		{`let a = "x"; a -= "y";`, "unknown operator: STRING - STRING"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		errObj, ok := evaluated.(*object.Error)
+
+		if !ok {
+			t.Errorf("no error object returned. got=%T (%+v)", evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expectedMessage {
+			t.Errorf("wrong error message. got=%q, want=%q", errObj.Message, tt.expectedMessage)
+		}
+	}
+}
+
+func TestConstStatement(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected any
+	}{
+		{"const a = 5; a;", 5},
+		{"const a = 5; let f = fn() { let a = 1; a = 2; a }; f();", 2},
+		{"const a = 5; let f = fn(a) { a += 1 }; f(1);", 2},
+		{"const a = 5; a = 10;", "cannot assign to constant: a"},
+		{"const a = 5; a += 1;", "cannot assign to constant: a"},
+		{"const a = 5; let f = fn() { a = 2 }; f();", "cannot assign to constant: a"},
+		{"const a = 5; let a = 10;", "cannot redeclare constant: a"},
+		{"const a = 5; const a = 10;", "cannot redeclare constant: a"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			testIntegerObject(t, evaluated, int64(expected))
+		case string:
+			errObj, ok := evaluated.(*object.Error)
+
+			if !ok {
+				t.Errorf("object is not Error. got=%T (%+v)", evaluated, evaluated)
+				continue
+			}
+
+			if errObj.Message != expected {
+				t.Errorf("wrong error message. got=%q, want=%q", errObj.Message, expected)
+			}
+		}
+	}
+}
+
+func TestFreezeFunction(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected any
+	}{
+		{"let a = freeze([1, 2]); a[0];", 1},
+		{"freeze(5);", 5},
+		{"let a = freeze([1, 2]); let b = push(a, 3); b[2] = 4; b[2];", 4},
+		{"let a = [1, 2]; freeze(a); a[0] = 3;", "cannot mutate frozen ARRAY"},
+		{"let a = freeze([[1], 2]); a[0][0] = 3;", "cannot mutate frozen ARRAY"},
+		{`let h = freeze({"a": 1}); h["a"] = 2;`, "cannot mutate frozen HASH"},
+		{`let h = freeze({"a": 1}); h.b = 2;`, "cannot mutate frozen HASH"},
+		{`let h = freeze({"a": {"b": [1]}}); h.a.b[0] = 2;`, "cannot mutate frozen ARRAY"},
+		{`let h = freeze({"a": {"b": [1]}}); h.a.c = 2;`, "cannot mutate frozen HASH"},
+		{"let a = [1]; a[0] = a; freeze(a); a[0][0] = 1;", "cannot mutate frozen ARRAY"},
+		{"freeze(1, 2);", "wrong number of arguments. got=2, want=1"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			testIntegerObject(t, evaluated, int64(expected))
+		case string:
+			errObj, ok := evaluated.(*object.Error)
+
+			if !ok {
+				t.Errorf("object is not Error. got=%T (%+v)", evaluated, evaluated)
+				continue
+			}
+
+			if errObj.Message != expected {
+				t.Errorf("wrong error message. got=%q, want=%q", errObj.Message, expected)
+			}
+		}
+	}
+}
+
+func TestDestructuring(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"let [a, b] = [1, 2]; a + b;", 3},
+		{"let [a, ...tail] = [1, 2, 3]; len(tail);", 2},
+		{"let [a, ...tail] = [1, 2, 3]; tail[1];", 3},
+		{"let [a, ...tail] = [1]; len(tail);", 0},
+		{"let [a, [b, c]] = [1, [2, 3]]; a + b + c;", 6},
+		{"let arr = [1, 2]; let [...copy] = arr; copy[0] = 9; arr[0];", 1},
+		{`let {name, age: years} = {"name": 1, "age": 2}; name + years;`, 3},
+		{`let {"a b": x, 1: y, true: z} = {"a b": 1, 1: 2, true: 3}; x + y + z;`, 6},
+		{`let {tags: [first, ...others]} = {"tags": [5, 6, 7]}; first + len(others);`, 7},
+		{"const [a, b] = [1, 2]; b;", 2},
+		{"let add = fn([a, b]) { a + b }; add([1, 2]);", 3},
+		{`let greet = fn({name}, n) { name + n }; greet({"name": 1}, 2);`, 3},
+		{"let first = fn([head, ...tail]) { head }; first([4, 5, 6]);", 4},
+	}
+
+	for _, tt := range tests {
+		testIntegerObject(t, testEval(tt.input), int64(tt.expected))
+	}
+}
+
+func TestDestructuringErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{"let [a, b] = 5;", "cannot destructure INTEGER as array"},
+		{"let [a, b] = [1];", "array pattern expects 2 elements, got 1"},
+		{"let [a, b] = [1, 2, 3];", "array pattern expects 2 elements, got 3"},
+		{"let [a, b, ...c] = [1];", "array pattern expects at least 2 elements, got 1"},
+		{"let [a, [b]] = [1, 2];", "cannot destructure INTEGER as array"},
+		{`let {name} = [1];`, "cannot destructure ARRAY as hash"},
+		{`let {name} = {"age": 1};`, "key not found in hash: name"},
+		{`let {age: [a]} = {"age": 1};`, "cannot destructure INTEGER as array"},
+		{"let f = fn([a, b]) { a }; f(1);", "cannot destructure INTEGER as array"},
+		{"let f = fn(a, b) { a }; f(1);", "wrong number of arguments. got=1, want=2"},
+		{"const a = 1; let [a, b] = [1, 2];", "cannot redeclare constant: a"},
+		{"let [a, b] = [1]; a;", "array pattern expects 2 elements, got 1"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		errObj, ok := evaluated.(*object.Error)
+
+		if !ok {
+			t.Errorf("no error object returned. got=%T (%+v)", evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expectedMessage {
+			t.Errorf("wrong error message. got=%q, want=%q", errObj.Message, tt.expectedMessage)
+		}
+	}
+}
+
+func TestMatchExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected any
+	}{
+		{"match (1) { 1 => 10, _ => 20 }", 10},
+		{"match (5) { 1 => 10, _ => 20 }", 20},
+		{"match (-1) { -1 => 10, _ => 20 }", 10},
+		{`match ("b") { "a" => 1, "b" => 2 }`, 2},
+		{"match (true) { false => 1, true => 2 }", 2},
+		{"match (3) { 1 | 2 => 1, 3 | 4 => 2 }", 2},
+		{"match (7) { n => n * 2 }", 14},
+		{"match ([1, 2]) { [a] => a, [a, b] => a + b }", 3},
+		{"match ([1, 2, 3]) { [first, ...rest] => first + len(rest) }", 3},
+		{"match ([]) { [x, ...rest] => 1, [] => 2 }", 2},
+		{"match ([1, [2, 3]]) { [_, [_, c]] => c }", 3},
+		{`match ({"kind": "circle", "r": 2}) { {kind: "square", side} => side, {kind: "circle", r} => r * 3 }`, 6},
+		{`match ({"a": 1}) { {b} => 1, {a: 1 | 2} => 2 }`, 2},
+		{"match (5) { n if n > 10 => 1, n if n > 3 => 2, _ => 3 }", 2},
+		{"match ([1, 2]) { [a, b] if a > b => a, [a, b] => b }", 2},
+		{"let n = 1; match (5) { n => n }; n;", 1},
+		{"let f = fn(x) { match (x) { 0 => { return 100 }, _ => 1 }; 50 }; f(0);", 100},
+		{"let f = fn(x) { match (x) { 0 => { return 100 }, _ => 1 }; 50 }; f(1);", 50},
+		{"match (1) { 1 => { let a = 2; a * 3 } }", 6},
+		{"match (1) { 2 => 1 }", "no match arm for value: 1"},
+		{`match ({"a": 1}) { [a] => a }`, "no match arm for value: {a: 1}"},
+		{"match (1) { n if n > 1 => 1 }", "no match arm for value: 1"},
+		{"match (1) { n if n + true => 1 }", "type mismatch: INTEGER + BOOLEAN"},
+		{"match (1) { _ => 1 + true }", "type mismatch: INTEGER + BOOLEAN"},
+		{"let x = match (1) { _ => {} }; x;", nil},
+		{"let r = match (1) { 1 => { let y = 2; } }; puts(r); r;", nil},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case nil:
+			testNullObject(t, evaluated)
+		case int:
+			testIntegerObject(t, evaluated, int64(expected))
+		case string:
+			errObj, ok := evaluated.(*object.Error)
+
+			if !ok {
+				t.Errorf("object is not Error. got=%T (%+v)", evaluated, evaluated)
+				continue
+			}
+
+			if errObj.Message != expected {
+				t.Errorf("wrong error message. got=%q, want=%q", errObj.Message, expected)
+			}
+		}
+	}
+}
+
+func TestWildcardAndLiteralPatternsInLet(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected any
+	}{
+		{"let [_, b] = [1, 2]; b;", 2},
+		{"let [0, b] = [0, 2]; b;", 2},
+		{"let [0, b] = [1, 2]; b;", "value 1 does not match pattern 0"},
+		{"let [_, b] = [1, 2]; _;", "identifier not found: _"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case int:
+			testIntegerObject(t, evaluated, int64(expected))
+		case string:
+			errObj, ok := evaluated.(*object.Error)
+
+			if !ok {
+				t.Errorf("object is not Error. got=%T (%+v)", evaluated, evaluated)
+				continue
+			}
+
+			if errObj.Message != expected {
+				t.Errorf("wrong error message. got=%q, want=%q", errObj.Message, expected)
+			}
+		}
+	}
+}
+
+func TestTryExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected any
+	}{
+		{"try { 1 } catch (e) { 2 }", 1},
+		{"try { throw 1; 2 } catch (e) { 3 }", 3},
+		{"try { throw 5 } catch (e) { e.value }", 5},
+		{`try { throw "boom" } catch (e) { e.message }`, "boom"},
+		{`try { throw "boom" } catch (e) { e.kind }`, "Error"},
+		{`try { throw {"message": "bad input", "kind": "ValueError"} } catch (e) { e.kind + ": " + e.message }`, "ValueError: bad input"},
+		{"try { 1 + true } catch (e) { e.message }", "type mismatch: INTEGER + BOOLEAN"},
+		{"try { 1 + true } catch (e) { e.kind }", "RuntimeError"},
+		{`try { len(1) } catch (e) { e.message }`, "argument to `len` not supported, got INTEGER"},
+		{"try { 1 + true } catch ({message, kind}) { kind }", "RuntimeError"},
+		{"try { missing } catch { 7 }", 7},
+		{"let f = fn() { throw 1 }; try { f() } catch (e) { 2 }", 2},
+		{"let f = fn() { throw 1 }; try { f(); 3 } catch (e) { 2 }", 2},
+		{`try { try { throw "inner" } catch (e) { throw e } } catch (e) { e.message }`, "inner"},
+		{`try { try { throw "inner" } finally { 1 } } catch (e) { e.message }`, "inner"},
+		{"let x = 0; try { 1 } finally { x = 5 }; x;", 5},
+		{"let x = 0; try { throw 1 } catch (e) { 2 } finally { x = 5 }; x;", 5},
+		{"try { 1 } catch (e) { 2 } finally { 3 }", 1},
+		{"try { throw 1 } catch (e) { 2 } finally { 3 }", 2},
+		{"let f = fn() { try { return 1 } finally { 2 } }; f();", 1},
+		{"let f = fn() { try { return 1 } finally { return 2 } }; f();", 2},
+		{"let f = fn() { try { return 1 } catch (e) { 2 }; 3 }; f();", 1},
+		{"try { 1 } catch (e) { 2 }; throw 3;", "3"},
+		{"try { throw 1 } finally { 2 }", "1"},
+		{"try { throw 1 } catch (e) { throw 2 }", "2"},
+		{"try { 1 } finally { 1 + true }", "type mismatch: INTEGER + BOOLEAN"},
+		{"try { throw 1 } catch ([a]) { a }", "cannot destructure HASH as array"},
+		{"match (1) { 2 => 2, _ => throw 3 }", "3"},
+		{"let x = try {} catch (e) { 1 }; x;", nil},
+		{`let r = try { throw "x" } catch (e) { let m = e.message; }; r;`, nil},
+		{"let x = try { let a = 1; } finally { 2 }; x;", nil},
+		{"puts(try { throw 1 } catch (e) { });", nil},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		switch expected := tt.expected.(type) {
+		case nil:
+			testNullObject(t, evaluated)
+		case int:
+			testIntegerObject(t, evaluated, int64(expected))
+		case string:
+			switch result := evaluated.(type) {
+			case *object.String:
+				if result.Value != expected {
+					t.Errorf("wrong string. got=%q, want=%q", result.Value, expected)
+				}
+			case *object.Error:
+				if result.Message != expected {
+					t.Errorf("wrong error message. got=%q, want=%q", result.Message, expected)
+				}
+			default:
+				t.Errorf("object is not String or Error. got=%T (%+v)", evaluated, evaluated)
+			}
+		}
+	}
+}
+
+func TestCaughtErrorStack(t *testing.T) {
+	input := `
+        let inner = fn(x) { throw x };
+        let outer = fn(x) { inner(x + 1) };
+
+        try { outer(1) } catch (e) { e.stack }
+    `
+
+	evaluated := testEval(input)
+	stack, ok := evaluated.(*object.Array)
+
+	if !ok {
+		t.Fatalf("object is not array. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	expected := []string{"inner((x + 1))", "outer(1)"}
+
+	if len(stack.Elements) != len(expected) {
+		t.Fatalf("stack has wrong length. got=%d, want=%d", len(stack.Elements), len(expected))
+	}
+
+	for i, frame := range expected {
+		str, ok := stack.Elements[i].(*object.String)
+
+		if !ok {
+			t.Errorf("frame is not string. got=%T (%+v)", stack.Elements[i], stack.Elements[i])
+			continue
+		}
+
+		if str.Value != frame {
+			t.Errorf("wrong frame %d. got=%q, want=%q", i, str.Value, frame)
+		}
+	}
+}
+
 func TestFunctionObject(t *testing.T) {
 	input := "fn(x) { x + 2; };"
 
@@ -242,8 +674,8 @@ func TestFunctionObject(t *testing.T) {
 		t.Fatalf("wrong number of parameters. got=%d, want=%d", len(fn.Parameters), 1)
 	}
 
-	if fn.Parameters[0].Value != "x" {
-		t.Fatalf("wrong parameter name. got=%s, want=%s", fn.Parameters[0].Value, "x")
+	if fn.Parameters[0].String() != "x" {
+		t.Fatalf("wrong parameter name. got=%s, want=%s", fn.Parameters[0].String(), "x")
 	}
 
 	expectedBody := "(x + 2)"
@@ -271,6 +703,31 @@ func TestFunctionApplication(t *testing.T) {
 	}
 }
 
+func TestFunctionsWithoutValueReturnNull(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"fn() {}()", "null"},
+		{"let f = fn(x) { let y = x; }; f(1)", "null"},
+		{"map([1, 2], fn(x) {})[0]", "null"},
+		{"filter([1, 2], fn(x) {})", "[]"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated == nil {
+			t.Errorf("%s evaluated to nil", tt.input)
+			continue
+		}
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
 func TestClosures(t *testing.T) {
 	input := `
         let newAdder = fn(x) {
@@ -284,6 +741,22 @@ func TestClosures(t *testing.T) {
 	testIntegerObject(t, testEval(input), 4)
 }
 
+func TestClosuresMutatingCapturedBindings(t *testing.T) {
+	input := `
+        let newCounter = fn() {
+            let count = 0;
+            fn() { count += 1 };
+        };
+
+        let counter = newCounter();
+        counter();
+        counter();
+        counter();
+    `
+
+	testIntegerObject(t, testEval(input), 3)
+}
+
 func TestLenFunction(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -353,7 +826,10 @@ func TestArrayIndexExpressions(t *testing.T) {
 		{"let myArray = [1, 2, 3]; myArray[0] + myArray[1] + myArray[2];", 6},
 		{"let myArray = [1, 2, 3]; let i = myArray[0]; myArray[i]", 2},
 		{"[1, 2, 3][3]", nil},
-		{`[1, 2, 3][-1]`, nil},
+		{`[1, 2, 3][-1]`, 3},
+		{`[1, 2, 3][-3]`, 1},
+		{`[1, 2, 3][-4]`, nil},
+		{`[][0]`, nil},
 	}
 
 	for _, tt := range tests {
@@ -409,28 +885,35 @@ func TestHashLiterals(t *testing.T) {
 		t.Fatalf("object is not hash. got=%T (%+v)", evaluated, evaluated)
 	}
 
-	expected := map[object.HashKey]int64{
-		(&object.String{Value: "one"}).HashKey():   1,
-		(&object.String{Value: "two"}).HashKey():   2,
-		(&object.String{Value: "three"}).HashKey(): 3,
-		(&object.Integer{Value: 4}).HashKey():      4,
-		TRUE.HashKey():                             5,
-		FALSE.HashKey():                            6,
+	expected := []struct {
+		key   object.Hashable
+		value int64
+	}{
+		{&object.String{Value: "one"}, 1},
+		{&object.String{Value: "two"}, 2},
+		{&object.String{Value: "three"}, 3},
+		{&object.Integer{Value: 4}, 4},
+		{TRUE, 5},
+		{FALSE, 6},
 	}
 
-	if len(result.Pairs) != len(expected) {
-		t.Fatalf("wrong number of pairs. got=%d, want=%d", len(result.Pairs), len(expected))
+	if result.Len() != len(expected) {
+		t.Fatalf("wrong number of pairs. got=%d, want=%d", result.Len(), len(expected))
 	}
 
-	for key, value := range expected {
-		pair, ok := result.Pairs[key]
+	for i, tt := range expected {
+		value, ok := result.Get(tt.key)
 
 		if !ok {
-			t.Errorf("pair not found. got=%v", key)
+			t.Errorf("pair not found. got=%v", tt.key.Inspect())
 			continue
 		}
 
-		testIntegerObject(t, pair.Value, value)
+		testIntegerObject(t, value, tt.value)
+
+		if pair := result.Pairs()[i]; pair.Key.Inspect() != tt.key.Inspect() {
+			t.Errorf("pair %d out of insertion order. got=%s, want=%s", i, pair.Key.Inspect(), tt.key.Inspect())
+		}
 	}
 }
 
@@ -460,6 +943,99 @@ func TestHashIndexExpressions(t *testing.T) {
 	}
 }
 
+func TestMemberExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`let person = {"name": "Ana"}; person.name`, "Ana"},
+		{`let person = {"name": "Ana"}; person.age`, "null"},
+		{`let config = {"db": {"host": "localhost", "ports": [5432]}}; config.db.host`, "localhost"},
+		{`let config = {"db": {"host": "localhost", "ports": [5432]}}; config.db.ports[0]`, "5432"},
+		{`let servers = [{"name": "a"}, {"name": "b"}]; servers[1].name`, "b"},
+		{`let h = {"double": fn(x) { x * 2 }}; h.double(4)`, "8"},
+		{`let make = fn() { {"inner": {"n": 1}} }; make().inner.n`, "1"},
+		{`let h = {"if": 1, "match": {"null": 2}}; h.if + h.match.null`, "3"},
+		{`let h = {}; h.true = 1; h["true"]`, "1"},
+		{`let key = "name"; let h = {"key": 1, "name": 2}; h.key`, "1"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestIndexAssignment(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected any
+	}{
+		{"let a = [1, 2, 3]; a[0] = 10; a[0];", 10},
+		{"let a = [1, 2, 3]; a[2] = 10;", 10},
+		{"let a = [1, 2, 3]; a[-1] = 10; a[2];", 10},
+		{"let a = [1, 2, 3]; a[1] += 5; a[1];", 7},
+		{"let a = [1, 2, 3]; let b = a; b[0] = 9; a[0];", 9},
+		{"let a = [[1], [2]]; a[1][0] = 5; a[1][0];", 5},
+		{`let h = {"a": 1}; h["a"] = 2; h["a"];`, 2},
+		{`let h = {}; h["a"] = 2; h["a"];`, 2},
+		{`let h = {}; h[1] = 2; h[true] = 3; h[1] + h[true];`, 5},
+		{`let h = {"a": 1}; h["a"] *= 10; h["a"];`, 10},
+		{`let h = {"a": 1}; h.a = 2; h["a"];`, 2},
+		{`let h = {}; h.b = 3; h.b;`, 3},
+		{`let h = {"a": 1}; h.a += 4; h.a;`, 5},
+		{`let h = {"inner": {"a": 1}}; h.inner.a = 7; h["inner"]["a"];`, 7},
+		{`let h = {}; h.missing;`, nil},
+		{`let make = fn() { let h = {"n": 0}; fn() { h.n += 1 } }; let inc = make(); inc(); inc();`, 2},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		integer, ok := tt.expected.(int)
+		if ok {
+			testIntegerObject(t, evaluated, int64(integer))
+		} else {
+			testNullObject(t, evaluated)
+		}
+	}
+}
+
+func TestIndexAssignmentErrors(t *testing.T) {
+	tests := []struct {
+		input           string
+		expectedMessage string
+	}{
+		{"let a = [1, 2, 3]; a[3] = 1;", "index out of range: 3 (length 3)"},
+		{"let a = [1, 2, 3]; a[-4] = 1;", "index out of range: -4 (length 3)"},
+		{"let a = []; a[0] = 1;", "index out of range: 0 (length 0)"},
+		{`let a = [1]; a["x"] = 1;`, "index assignment not supported: ARRAY"},
+		{"let a = 5; a[0] = 1;", "index assignment not supported: INTEGER"},
+		{"let h = {}; h[fn(x) { x }] = 1;", "unusable as hash key: FUNCTION"},
+		{`let h = {}; h["a"] += 1;`, "type mismatch: NULL + INTEGER"},
+		{"let a = [1]; a.x = 1;", "member assignment not supported: ARRAY"},
+		{"let a = 5; a.x;", "member access not supported: INTEGER"},
+		{"b[0] = 1;", "identifier not found: b"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		errObj, ok := evaluated.(*object.Error)
+
+		if !ok {
+			t.Errorf("no error object returned. got=%T (%+v)", evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expectedMessage {
+			t.Errorf("wrong error message. got=%q, want=%q", errObj.Message, tt.expectedMessage)
+		}
+	}
+}
+
 func testNullObject(t *testing.T, obj object.Object) bool {
 	if obj != NULL {
 		t.Errorf("object is not null. got=%T (%+v)", obj, obj)
@@ -470,12 +1046,14 @@ func testNullObject(t *testing.T, obj object.Object) bool {
 }
 
 func testEval(input string) object.Object {
+	return testEvalWithEnv(input, NewEnvironment())
+}
+
+func testEvalWithEnv(input string, env *object.Environment) object.Object {
 	l := lexer.New(input)
 	p := parser.New(l)
 	program := p.ParseProgram()
 
-	env := object.NewEnvironment()
-
 	return Eval(program, env)
 }
 
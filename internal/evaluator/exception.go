@@ -0,0 +1,128 @@
+package evaluator
+
+import (
+	"github.com/RafaLopesMelo/monkey-lang/internal/ast"
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func evalThrowExpression(node *ast.ThrowExpression, env *object.Environment) object.Object {
+	val := Eval(node.Value, env)
+
+	if isError(val) {
+		return val
+	}
+
+	return newThrownError(val)
+}
+
+// Wraps a thrown value into an error. Strings become the message, while hashes may carry their
+// own "message" and "kind", so rethrowing a caught error keeps both.
+func newThrownError(val object.Object) *object.Error {
+	err := &object.Error{
+		Message: val.Inspect(),
+		Kind:    object.THROWN_ERROR,
+		Value:   val,
+	}
+
+	hash, ok := val.(*object.Hash)
+	if !ok {
+		return err
+	}
+
+	if message, ok := hashStringValue(hash, "message"); ok {
+		err.Message = message
+	}
+
+	if kind, ok := hashStringValue(hash, "kind"); ok {
+		err.Kind = kind
+	}
+
+	return err
+}
+
+func hashStringValue(hash *object.Hash, key string) (string, bool) {
+	value, ok := hash.Get(&object.String{Value: key})
+	if !ok {
+		return "", false
+	}
+
+	str, ok := value.(*object.String)
+	if !ok {
+		return "", false
+	}
+
+	return str.Value, true
+}
+
+// Evaluates the try block, handing any error it produces to the catch block. The finally block
+// always runs afterwards and its result is discarded, unless it errors or returns itself.
+func evalTryExpression(node *ast.TryExpression, env *object.Environment) object.Object {
+	result := Eval(node.Block, env)
+
+	// Empty blocks, or blocks ending in a let, produce no value at all
+	if result == nil {
+		result = NULL
+	}
+
+	if err, ok := result.(*object.Error); ok && node.Catch != nil {
+		result = evalCatch(node, err, env)
+	}
+
+	if node.Finally != nil {
+		finally := Eval(node.Finally, env)
+
+		if finally != nil {
+			ft := finally.Type()
+
+			if ft == object.ERROR_OBJ || ft == object.RETURN_VALUE_OBJ {
+				return finally
+			}
+		}
+	}
+
+	return result
+}
+
+func evalCatch(node *ast.TryExpression, err *object.Error, env *object.Environment) object.Object {
+	catchEnv := object.NewEnclosedEnvironment(env)
+
+	if node.CatchParam != nil {
+		bindings, bindErr := destructure(node.CatchParam, errorToHash(err), nil, env)
+		if bindErr != nil {
+			return bindErr
+		}
+
+		for _, b := range bindings {
+			catchEnv.Set(b.name, b.value)
+		}
+	}
+
+	result := Eval(node.Catch, catchEnv)
+
+	if result == nil {
+		return NULL
+	}
+
+	return result
+}
+
+// Exposes a caught error to user code as a hash with its message, kind, stack and thrown value
+func errorToHash(err *object.Error) *object.Hash {
+	stack := make([]object.Object, len(err.Stack))
+	for idx, frame := range err.Stack {
+		stack[idx] = &object.String{Value: frame}
+	}
+
+	var value object.Object = NULL
+	if err.Value != nil {
+		value = err.Value
+	}
+
+	hash := object.NewHash()
+	hash.Set(&object.String{Value: "message"}, &object.String{Value: err.Message})
+	hash.Set(&object.String{Value: "kind"}, &object.String{Value: err.Kind})
+	hash.Set(&object.String{Value: "stack"}, &object.Array{Elements: stack})
+	hash.Set(&object.String{Value: "value"}, value)
+
+	return hash
+}
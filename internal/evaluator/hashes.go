@@ -0,0 +1,161 @@
+package evaluator
+
+import (
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+// Hash builtins return arrays and hashes in the insertion order of the pairs they come from
+func init() {
+	hashBuiltins := map[string]object.BuiltinFunction{
+		"keys":         builtinKeys,
+		"values":       builtinValues,
+		"entries":      builtinEntries,
+		"has":          builtinHas,
+		"delete":       builtinDelete,
+		"merge":        builtinMerge,
+		"from_entries": builtinFromEntries,
+	}
+
+	for name, fn := range hashBuiltins {
+		builtins[name] = &object.Builtin{Fn: fn}
+	}
+}
+
+func builtinKeys(args ...object.Object) object.Object {
+	return mapHashPairs("keys", args, func(pair object.HashPair) object.Object {
+		return pair.Key
+	})
+}
+
+func builtinValues(args ...object.Object) object.Object {
+	return mapHashPairs("values", args, func(pair object.HashPair) object.Object {
+		return pair.Value
+	})
+}
+
+func builtinEntries(args ...object.Object) object.Object {
+	return mapHashPairs("entries", args, func(pair object.HashPair) object.Object {
+		return &object.Array{Elements: []object.Object{pair.Key, pair.Value}}
+	})
+}
+
+func mapHashPairs(name string, args []object.Object, fn func(object.HashPair) object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	hash, err := hashArgument(name, args[0])
+	if err != nil {
+		return err
+	}
+
+	elements := make([]object.Object, hash.Len())
+
+	for idx, pair := range hash.Pairs() {
+		elements[idx] = fn(pair)
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+func builtinHas(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	hash, err := hashArgument("has", args[0])
+	if err != nil {
+		return err
+	}
+
+	key, ok := object.AsHashable(args[1])
+	if !ok {
+		return newError("unusable as hash key: %s", args[1].Type())
+	}
+
+	_, found := hash.Get(key)
+
+	return nativeBoolToBooleanObject(found)
+}
+
+// Removes the key from the hash in place, returning whether it was there
+func builtinDelete(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	hash, err := hashArgument("delete", args[0])
+	if err != nil {
+		return err
+	}
+
+	key, ok := object.AsHashable(args[1])
+	if !ok {
+		return newError("unusable as hash key: %s", args[1].Type())
+	}
+
+	if hash.Frozen {
+		return newError("cannot mutate frozen %s", hash.Type())
+	}
+
+	return nativeBoolToBooleanObject(hash.Delete(key))
+}
+
+// Combines the hashes into a new one, the values of later hashes replacing the ones of earlier hashes
+func builtinMerge(args ...object.Object) object.Object {
+	merged := object.NewHash()
+
+	for _, arg := range args {
+		hash, err := hashArgument("merge", arg)
+		if err != nil {
+			return err
+		}
+
+		for _, pair := range hash.Pairs() {
+			merged.Set(pair.Key.(object.Hashable), pair.Value)
+		}
+	}
+
+	return merged
+}
+
+// Builds a hash from an array of [key, value] pairs, the inverse of entries
+func builtinFromEntries(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	seq, err := sequenceArgument("from_entries", args[0])
+	if err != nil {
+		return err
+	}
+
+	hash := object.NewHash()
+
+	for idx := range seq.Len() {
+		element := seq.At(idx)
+
+		entry, ok := element.(*object.Array)
+		if !ok || len(entry.Elements) != 2 {
+			return newError("entry must be a [key, value] pair, got %s", element.Inspect())
+		}
+
+		key, ok := object.AsHashable(entry.Elements[0])
+		if !ok {
+			return newError("unusable as hash key: %s", entry.Elements[0].Type())
+		}
+
+		hash.Set(key, entry.Elements[1])
+	}
+
+	return hash
+}
+
+func hashArgument(name string, arg object.Object) (*object.Hash, *object.Error) {
+	hash, ok := arg.(*object.Hash)
+	if !ok {
+		return nil, newError("argument to `%s` not supported, got %s", name, arg.Type())
+	}
+
+	return hash, nil
+}
@@ -0,0 +1,217 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestHashesKeepInsertionOrder(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`{"b": 1, "a": 2, 3: 3, true: 4}`, "{b: 1, a: 2, 3: 3, true: 4}"},
+		{`let h = {"b": 1, "a": 2}; h["c"] = 3; h["b"] = 4; h`, "{b: 4, a: 2, c: 3}"},
+		{`let h = {"a": 1, "b": 2, "c": 3}; delete(h, "a"); h["a"] = 4; h`, "{b: 2, c: 3, a: 4}"},
+		{`group_by([3, 1, 2], fn(x) { x % 2 })`, "{1: [3, 1], 0: [2]}"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestHashBuiltins(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`keys({"b": 1, "a": 2})`, "[b, a]"},
+		{`keys({})`, "[]"},
+		{`values({"b": 1, "a": 2})`, "[1, 2]"},
+		{`entries({"b": 1, 2: true})`, "[[b, 1], [2, true]]"},
+		{`has({"a": 1}, "a")`, "true"},
+		{`has({"a": 1}, "b")`, "false"},
+		{`has({1: 1}, "1")`, "false"},
+		{`let h = {"a": 1, "b": 2}; [delete(h, "a"), h]`, "[true, {b: 2}]"},
+		{`let h = {"a": 1}; [delete(h, "z"), h]`, "[false, {a: 1}]"},
+		{`let h = {"a": 1, "b": 2, "c": 3}; delete(h, "b"); [h["a"], h["b"], h["c"], len(keys(h))]`, "[1, null, 3, 2]"},
+		{`merge({"a": 1, "b": 2}, {"b": 3, "c": 4})`, "{a: 1, b: 3, c: 4}"},
+		{`merge()`, "{}"},
+		{`let h = {"a": 1}; merge(h, {"a": 2}); h`, "{a: 1}"},
+		{`from_entries([["a", 1], [2, "b"]])`, "{a: 1, 2: b}"},
+		{`from_entries([["a", 1], ["a", 2]])`, "{a: 2}"},
+		{`from_entries(entries({"x": 1, "y": 2}))`, "{x: 1, y: 2}"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestHashBuiltinErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`keys([1])`, "argument to `keys` not supported, got ARRAY"},
+		{`values({}, {})`, "wrong number of arguments. got=2, want=1"},
+		{`has({}, [])`, "unusable as hash key: ARRAY"},
+		{`delete(freeze({"a": 1}), "a")`, "cannot mutate frozen HASH"},
+		{`merge({}, 1)`, "argument to `merge` not supported, got INTEGER"},
+		{`from_entries([["a"]])`, "entry must be a [key, value] pair, got [a]"},
+		{`from_entries([1])`, "entry must be a [key, value] pair, got 1"},
+		{`from_entries([[[], 1]])`, "unusable as hash key: ARRAY"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
+
+func TestFrozenCompositeHashKeys(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`let grid = {freeze([0, 1]): "a"}; grid[freeze([0, 1])]`, "a"},
+		{`let grid = {freeze([0, 1]): "a"}; grid[freeze([1, 0])]`, "null"},
+		{`let k = freeze([1, [2, 3]]); let h = {}; h[k] = 1; h[freeze([1, [2, 3]])]`, "1"},
+		{`let h = {freeze({"x": 1, "y": 2}): "p"}; h[freeze({"y": 2, "x": 1})]`, "p"},
+		{`let h = {freeze([]): 1, freeze({}): 2}; [h[freeze([])], h[freeze({})]]`, "[1, 2]"},
+		{`let h = {freeze([1]): 1}; h[freeze([1])] = 2; h`, "{[1]: 2}"},
+		{`let h = {freeze([1]): 1, 1: 2}; [h[1], len(keys(h))]`, "[2, 2]"},
+		{`has({freeze(["a"]): 1}, freeze(["a"]))`, "true"},
+		{`let h = {freeze([1]): 1, freeze([2]): 2}; delete(h, freeze([1])); h`, "{[2]: 2}"},
+		{`unique([freeze([1]), freeze([1]), freeze([2])])`, "[[1], [2]]"},
+		{`let {x} = {"x": freeze([1])}; {x: 1}[freeze([1])]`, "1"},
+		{`match ({freeze([1, 2]): "pair"}) { {x: v} => v, _ => 0 }`, "0"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestUnhashableCompositeKeys(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`{[1, 2]: 1}`, "unusable as hash key: ARRAY"},
+		{`{{}: 1}`, "unusable as hash key: HASH"},
+		{`{freeze([1, fn() {}]): 1}`, "unusable as hash key: ARRAY"},
+		{`{freeze([1.5]): 1}`, "unusable as hash key: ARRAY"},
+		{`let a = [1]; a[0] = a; freeze(a); {a: 1}`, "unusable as hash key: ARRAY"},
+		{`let h = {}; h[[1]] = 1`, "unusable as hash key: ARRAY"},
+		{`{freeze([1]): 1}[[1]]`, "unusable as hash key: ARRAY"},
+		{`let x = 0; let y = 1; {[x, y]: "cell"}`, "unusable as hash key: ARRAY"},
+		{`let grid = {freeze([0, 1]): "cell"}; has(grid, [0, 1])`, "unusable as hash key: ARRAY"},
+		{`let grid = {freeze({"x": 0}): "cell"}; grid[{"x": 0}]`, "unusable as hash key: HASH"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
+
+func TestFrozenCompositeHashKeysMatchStructuralEquality(t *testing.T) {
+	tests := []struct {
+		a string
+		b string
+	}{
+		{`freeze([1, "a", true])`, `freeze([1, "a", true])`},
+		{`freeze([[1], {"k": [2]}])`, `freeze([[1], {"k": [2]}])`},
+		{`freeze({"a": 1, "b": 2})`, `freeze({"b": 2, "a": 1})`},
+	}
+
+	for _, tt := range tests {
+		a, ok := object.AsHashable(testEval(tt.a))
+		if !ok {
+			t.Fatalf("%s is not hashable", tt.a)
+		}
+
+		b, ok := object.AsHashable(testEval(tt.b))
+		if !ok {
+			t.Fatalf("%s is not hashable", tt.b)
+		}
+
+		if !object.Equal(a, b) {
+			t.Errorf("%s and %s are not equal", tt.a, tt.b)
+		}
+
+		if a.HashKey() != b.HashKey() {
+			t.Errorf("%s and %s have different hash keys", tt.a, tt.b)
+		}
+	}
+}
+
+// Always hashes to the same key, so every instance collides with the others
+type collidingKey struct {
+	name string
+}
+
+func (c *collidingKey) Type() object.ObjectType { return "COLLIDING" }
+func (c *collidingKey) Inspect() string         { return c.name }
+func (c *collidingKey) HashKey() object.HashKey { return object.HashKey{Type: "COLLIDING", Value: 1} }
+
+func TestHashKeyCollisions(t *testing.T) {
+	a := &collidingKey{name: "a"}
+	b := &collidingKey{name: "b"}
+
+	hash := object.NewHash()
+	hash.Set(a, &object.Integer{Value: 1})
+	hash.Set(b, &object.Integer{Value: 2})
+
+	if hash.Len() != 2 {
+		t.Fatalf("colliding keys overwrote each other. got=%s", hash.Inspect())
+	}
+
+	value, _ := hash.Get(a)
+	testIntegerObject(t, value, 1)
+
+	value, _ = hash.Get(b)
+	testIntegerObject(t, value, 2)
+
+	hash.Delete(a)
+
+	if _, ok := hash.Get(a); ok {
+		t.Errorf("deleted key still found")
+	}
+
+	value, _ = hash.Get(b)
+	testIntegerObject(t, value, 2)
+}
@@ -0,0 +1,269 @@
+package evaluator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func init() {
+	builtins["json_encode"] = &object.Builtin{Fn: builtinJSONEncode}
+	builtins["json_decode"] = &object.Builtin{Fn: builtinJSONDecode}
+}
+
+// Encodes a value as JSON, keeping hash keys in insertion order. Options: {"pretty": true} indents
+// the output with two spaces. The key must be quoted, as {pretty: true} names a variable instead.
+func builtinJSONEncode(args ...object.Object) object.Object {
+	if len(args) != 1 && len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=1 or 2", len(args))
+	}
+
+	pretty := false
+
+	if len(args) == 2 {
+		options, err := hashArgument("json_encode", args[1])
+		if err != nil {
+			return err
+		}
+
+		for _, pair := range options.Pairs() {
+			if key, ok := pair.Key.(*object.String); !ok || key.Value != "pretty" {
+				return newError("unknown json_encode option: %s", pair.Key.Inspect())
+			}
+
+			if pair.Value.Type() != object.BOOLEAN_OBJ {
+				return newError("json_encode option pretty must be BOOLEAN, got %s", pair.Value.Type())
+			}
+
+			pretty = pair.Value == TRUE
+		}
+	}
+
+	var out bytes.Buffer
+
+	encoder := &jsonEncoder{out: &out, visiting: map[object.Object]bool{}}
+	if err := encoder.encode(args[0]); err != nil {
+		return err
+	}
+
+	if pretty {
+		var indented bytes.Buffer
+		json.Indent(&indented, out.Bytes(), "", "  ")
+
+		return &object.String{Value: indented.String()}
+	}
+
+	return &object.String{Value: out.String()}
+}
+
+type jsonEncoder struct {
+	out      *bytes.Buffer
+	visiting map[object.Object]bool // Arrays and hashes being encoded, to detect cycles
+}
+
+func (e *jsonEncoder) encode(obj object.Object) *object.Error {
+	switch obj := obj.(type) {
+	case *object.Null:
+		e.out.WriteString("null")
+	case *object.Boolean, *object.Integer:
+		e.out.WriteString(obj.Inspect())
+	case *object.Float:
+		if math.IsNaN(obj.Value) || math.IsInf(obj.Value, 0) {
+			return newError("cannot encode %s as JSON", obj.Inspect())
+		}
+
+		e.out.WriteString(obj.Inspect())
+	case *object.String:
+		e.encodeString(obj.Value)
+	case *object.Array:
+		return e.encodeContainer(obj, func() *object.Error {
+			e.out.WriteString("[")
+
+			for idx, element := range obj.Elements {
+				if idx > 0 {
+					e.out.WriteString(",")
+				}
+
+				if err := e.encode(element); err != nil {
+					return err
+				}
+			}
+
+			e.out.WriteString("]")
+			return nil
+		})
+	case *object.Hash:
+		return e.encodeContainer(obj, func() *object.Error {
+			e.out.WriteString("{")
+
+			for idx, pair := range obj.Pairs() {
+				key, ok := pair.Key.(*object.String)
+				if !ok {
+					return newError("cannot encode %s hash key %s as JSON, keys must be strings", pair.Key.Type(), pair.Key.Inspect())
+				}
+
+				if idx > 0 {
+					e.out.WriteString(",")
+				}
+
+				e.encodeString(key.Value)
+				e.out.WriteString(":")
+
+				if err := e.encode(pair.Value); err != nil {
+					return err
+				}
+			}
+
+			e.out.WriteString("}")
+			return nil
+		})
+	default:
+		return newError("cannot encode %s as JSON", obj.Type())
+	}
+
+	return nil
+}
+
+func (e *jsonEncoder) encodeContainer(obj object.Object, encode func() *object.Error) *object.Error {
+	if e.visiting[obj] {
+		return newError("cannot encode cyclic %s as JSON", obj.Type())
+	}
+
+	e.visiting[obj] = true
+	defer delete(e.visiting, obj)
+
+	return encode()
+}
+
+func (e *jsonEncoder) encodeString(value string) {
+	encoder := json.NewEncoder(e.out)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(value)
+
+	// Encode terminates every value with a newline
+	e.out.Truncate(e.out.Len() - 1)
+}
+
+// Decodes JSON into integers, floats, strings, booleans, null, arrays and hashes. Numbers without a
+// fraction or exponent become integers, and objects keep their keys in the order they appear.
+func builtinJSONDecode(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	source, err := stringArgument("json_decode", args[0])
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(source))
+	decoder.UseNumber()
+
+	value, decodeErr := decodeJSONValue(decoder)
+	if decodeErr != nil {
+		return newError("invalid JSON: %s", decodeErr)
+	}
+
+	if _, trailingErr := decoder.Token(); trailingErr != io.EOF {
+		return newError("invalid JSON: unexpected data after the top-level value")
+	}
+
+	return value
+}
+
+func decodeJSONValue(decoder *json.Decoder) (object.Object, error) {
+	tok, err := decoder.Token()
+	if err == io.EOF {
+		return nil, errors.New("unexpected end of JSON input")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok := tok.(type) {
+	case nil:
+		return NULL, nil
+	case bool:
+		return nativeBoolToBooleanObject(tok), nil
+	case string:
+		return &object.String{Value: tok}, nil
+	case json.Number:
+		return decodeJSONNumber(tok)
+	case json.Delim:
+		if tok == '[' {
+			return decodeJSONArray(decoder)
+		}
+
+		return decodeJSONObject(decoder)
+	default:
+		return nil, errors.New("unexpected token")
+	}
+}
+
+func decodeJSONNumber(number json.Number) (object.Object, error) {
+	if !strings.ContainsAny(number.String(), ".eE") {
+		if value, err := strconv.ParseInt(number.String(), 10, 64); err == nil {
+			return &object.Integer{Value: value}, nil
+		}
+	}
+
+	value, err := number.Float64()
+	if err != nil {
+		return nil, err
+	}
+
+	return &object.Float{Value: value}, nil
+}
+
+func decodeJSONArray(decoder *json.Decoder) (object.Object, error) {
+	elements := []object.Object{}
+
+	for decoder.More() {
+		element, err := decodeJSONValue(decoder)
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	// Closing ]
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	return &object.Array{Elements: elements}, nil
+}
+
+func decodeJSONObject(decoder *json.Decoder) (object.Object, error) {
+	hash := object.NewHash()
+
+	for decoder.More() {
+		// The decoder itself rejects keys that aren't strings
+		tok, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := decodeJSONValue(decoder)
+		if err != nil {
+			return nil, err
+		}
+
+		hash.Set(&object.String{Value: tok.(string)}, value)
+	}
+
+	// Closing }
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	return hash, nil
+}
@@ -0,0 +1,145 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestJSONEncode(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`json_encode(1)`, `1`},
+		{`json_encode(-2.5)`, `-2.5`},
+		{`json_encode(2.0)`, `2.0`},
+		{`json_encode(true)`, `true`},
+		{`json_encode(if (false) { 1 })`, `null`},
+		{`json_encode("a\b <tag> é")`, `"a\\b <tag> é"`},
+		{`json_encode([1, "two", [3]])`, `[1,"two",[3]]`},
+		{`json_encode({"b": 1, "a": [true, {}], "c": {"d": []}})`, `{"b":1,"a":[true,{}],"c":{"d":[]}}`},
+		{`let a = [1]; json_encode([a, a])`, `[[1],[1]]`},
+		{`json_encode({"a": [1, 2], "b": {}}, {"pretty": true})`, "{\n  \"a\": [\n    1,\n    2\n  ],\n  \"b\": {}\n}"},
+		{`json_encode([1], {"pretty": false})`, `[1]`},
+		{`let option = "pretty"; json_encode([1], {option: true})`, "[\n  1\n]"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		str, ok := evaluated.(*object.String)
+		if !ok {
+			t.Errorf("%s: object is not String. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if str.Value != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, str.Value, tt.expected)
+		}
+	}
+}
+
+func TestJSONDecode(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`1`, "1"},
+		{`-1.5`, "-1.5"},
+		{`1e3`, "1000.0"},
+		{`2.0`, "2.0"},
+		{`true`, "true"},
+		{`null`, "null"},
+		{` [1, "a", [null]] `, "[1, a, [null]]"},
+		{`{"z": 1, "a": {"b": [2]}, "m": 3}`, "{z: 1, a: {b: [2]}, m: 3}"},
+		{`{"a": 1, "a": 2}`, "{a: 2}"},
+		{`{}`, "{}"},
+		{`"\u00e9 \"q\""`, `é "q"`},
+		{`12345678901234567890`, "12345678901234567000.0"},
+	}
+
+	for _, tt := range tests {
+		decoded := builtinJSONDecode(&object.String{Value: tt.input})
+
+		if decoded.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, decoded.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	input := `
+	let h = {"b": [1, 2.5, "x"], "a": {"c": true, "d": if (false) { 1 }}};
+	let encoded = json_encode(h);
+	[encoded == json_encode(json_decode(encoded)), json_decode(encoded)]
+	`
+
+	evaluated := testEval(input)
+	expected := "[true, {b: [1, 2.5, x], a: {c: true, d: null}}]"
+
+	if evaluated.Inspect() != expected {
+		t.Errorf("wrong result. got=%q, want=%q", evaluated.Inspect(), expected)
+	}
+}
+
+func TestJSONDecodeErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{``, "invalid JSON: unexpected end of JSON input"},
+		{`[1,`, "invalid JSON: unexpected end of JSON input"},
+		{`]`, "invalid JSON: invalid character ']' looking for beginning of value"},
+		{`{1: 2}`, "invalid JSON: object member name must be a string"},
+		{`1 2`, "invalid JSON: unexpected data after the top-level value"},
+		{`nope`, "invalid JSON: invalid character 'o' in literal null (expecting 'u')"},
+	}
+
+	for _, tt := range tests {
+		decoded := builtinJSONDecode(&object.String{Value: tt.input})
+
+		errObj, ok := decoded.(*object.Error)
+		if !ok {
+			t.Errorf("%q: object is not Error. got=%T (%+v)", tt.input, decoded, decoded)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%q: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`json_encode(fn(x) { x })`, "cannot encode FUNCTION as JSON"},
+		{`json_encode([len])`, "cannot encode BUILTIN as JSON"},
+		{`json_encode({1: "a"})`, "cannot encode INTEGER hash key 1 as JSON, keys must be strings"},
+		{`let a = [1]; a[0] = a; json_encode(a)`, "cannot encode cyclic ARRAY as JSON"},
+		{`let h = {}; h["self"] = h; json_encode(h)`, "cannot encode cyclic HASH as JSON"},
+		{`json_encode(math.sqrt(-1))`, "cannot encode NaN as JSON"},
+		{`json_encode(1, {"indent": 2})`, "unknown json_encode option: indent"},
+		{`json_encode(1, {"pretty": 1})`, "json_encode option pretty must be BOOLEAN, got INTEGER"},
+		{`json_encode(1, {pretty: true})`, "identifier not found: pretty"},
+		{`json_encode(1, true)`, "argument to `json_encode` not supported, got BOOLEAN"},
+		{`json_decode(1)`, "argument to `json_decode` not supported, got INTEGER"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package evaluator
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+// Generator behind math.random, seeded from the clock unless the host calls SetRandomSeed
+var random = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// Makes math.random return the same sequence of numbers on every run with the same seed
+func SetRandomSeed(seed int64) {
+	random = rand.New(rand.NewSource(seed))
+}
+
+func init() {
+	functions := map[string]object.BuiltinFunction{
+		"abs":    mathAbs,
+		"min":    mathExtreme("min", -1),
+		"max":    mathExtreme("max", 1),
+		"pow":    mathPow,
+		"sqrt":   floatFunction("sqrt", math.Sqrt),
+		"floor":  roundingFunction("floor", math.Floor),
+		"ceil":   roundingFunction("ceil", math.Ceil),
+		"round":  mathRound,
+		"sin":    floatFunction("sin", math.Sin),
+		"cos":    floatFunction("cos", math.Cos),
+		"tan":    floatFunction("tan", math.Tan),
+		"asin":   floatFunction("asin", math.Asin),
+		"acos":   floatFunction("acos", math.Acos),
+		"atan":   floatFunction("atan", math.Atan),
+		"atan2":  mathAtan2,
+		"exp":    floatFunction("exp", math.Exp),
+		"log":    floatFunction("log", math.Log),
+		"log2":   floatFunction("log2", math.Log2),
+		"log10":  floatFunction("log10", math.Log10),
+		"random": mathRandom,
+	}
+
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	namespace := object.NewHash()
+
+	for _, name := range names {
+		namespace.Set(&object.String{Value: name}, &object.Builtin{Fn: functions[name]})
+	}
+
+	namespace.Set(&object.String{Value: "PI"}, &object.Float{Value: math.Pi})
+	namespace.Set(&object.String{Value: "E"}, &object.Float{Value: math.E})
+	namespace.Freeze()
+
+	namespaces["math"] = namespace
+}
+
+func mathAbs(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	switch arg := args[0].(type) {
+	case *object.Integer:
+		if arg.Value < 0 {
+			return &object.Integer{Value: -arg.Value}
+		}
+
+		return arg
+	case *object.Float:
+		return &object.Float{Value: math.Abs(arg.Value)}
+	default:
+		return newError("argument to `abs` not supported, got %s", arg.Type())
+	}
+}
+
+// Returns the smallest or largest of the arguments, keeping its type. The sign tells which one.
+func mathExtreme(name string, sign float64) object.BuiltinFunction {
+	return func(args ...object.Object) object.Object {
+		if len(args) == 0 {
+			return newError("wrong number of arguments. got=0, want at least 1")
+		}
+
+		var result object.Object
+		var resultVal float64
+
+		for _, arg := range args {
+			value, ok := toFloat(arg)
+			if !ok {
+				return newError("argument to `%s` not supported, got %s", name, arg.Type())
+			}
+
+			if result == nil || (value-resultVal)*sign > 0 {
+				result = arg
+				resultVal = value
+			}
+		}
+
+		return result
+	}
+}
+
+// Raises integers to non negative integer powers exactly, anything else as floats
+func mathPow(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	base, exponent := args[0], args[1]
+
+	if base, ok := base.(*object.Integer); ok {
+		if exponent, ok := exponent.(*object.Integer); ok && exponent.Value >= 0 {
+			return &object.Integer{Value: integerPow(base.Value, exponent.Value)}
+		}
+	}
+
+	baseVal, err := numberArgument("pow", base)
+	if err != nil {
+		return err
+	}
+
+	exponentVal, err := numberArgument("pow", exponent)
+	if err != nil {
+		return err
+	}
+
+	return &object.Float{Value: math.Pow(baseVal, exponentVal)}
+}
+
+// Exponentiation by squaring, wrapping around on overflow like the other integer operators
+func integerPow(base int64, exponent int64) int64 {
+	result := int64(1)
+
+	for exponent > 0 {
+		if exponent%2 == 1 {
+			result *= base
+		}
+
+		base *= base
+		exponent /= 2
+	}
+
+	return result
+}
+
+func floatFunction(name string, fn func(float64) float64) object.BuiltinFunction {
+	return func(args ...object.Object) object.Object {
+		if len(args) != 1 {
+			return newError("wrong number of arguments. got=%d, want=1", len(args))
+		}
+
+		value, err := numberArgument(name, args[0])
+		if err != nil {
+			return err
+		}
+
+		return &object.Float{Value: fn(value)}
+	}
+}
+
+func mathAtan2(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	y, err := numberArgument("atan2", args[0])
+	if err != nil {
+		return err
+	}
+
+	x, err := numberArgument("atan2", args[1])
+	if err != nil {
+		return err
+	}
+
+	return &object.Float{Value: math.Atan2(y, x)}
+}
+
+// Rounds a number to an integer
+func roundingFunction(name string, fn func(float64) float64) object.BuiltinFunction {
+	return func(args ...object.Object) object.Object {
+		if len(args) != 1 {
+			return newError("wrong number of arguments. got=%d, want=1", len(args))
+		}
+
+		return roundToInteger(name, args[0], fn)
+	}
+}
+
+// Rounds half away from zero to an integer, or to a float with the given number of decimal places
+func mathRound(args ...object.Object) object.Object {
+	if len(args) != 1 && len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=1 or 2", len(args))
+	}
+
+	if len(args) == 1 {
+		return roundToInteger("round", args[0], math.Round)
+	}
+
+	value, err := numberArgument("round", args[0])
+	if err != nil {
+		return err
+	}
+
+	places, err := integerArgument("round", args[1])
+	if err != nil {
+		return err
+	}
+
+	scale := math.Pow(10, float64(places))
+
+	return &object.Float{Value: math.Round(value*scale) / scale}
+}
+
+func roundToInteger(name string, arg object.Object, fn func(float64) float64) object.Object {
+	switch arg := arg.(type) {
+	case *object.Integer:
+		return arg
+	case *object.Float:
+		rounded := fn(arg.Value)
+
+		if math.IsNaN(rounded) || rounded < math.MinInt64 || rounded >= math.MaxInt64 {
+			return newError("cannot convert %s to INTEGER", arg.Inspect())
+		}
+
+		return &object.Integer{Value: int64(rounded)}
+	default:
+		return newError("argument to `%s` not supported, got %s", name, arg.Type())
+	}
+}
+
+// Returns a float in [0, 1) without arguments, or an integer in [0, n) for a positive integer n
+func mathRandom(args ...object.Object) object.Object {
+	switch len(args) {
+	case 0:
+		return &object.Float{Value: random.Float64()}
+	case 1:
+		n, err := integerArgument("random", args[0])
+		if err != nil {
+			return err
+		}
+
+		if n < 1 {
+			return newError("random bound must be positive, got %d", n)
+		}
+
+		return &object.Integer{Value: random.Int63n(n)}
+	default:
+		return newError("wrong number of arguments. got=%d, want=0 or 1", len(args))
+	}
+}
+
+func numberArgument(name string, arg object.Object) (float64, *object.Error) {
+	value, ok := toFloat(arg)
+	if !ok {
+		return 0, newError("argument to `%s` not supported, got %s", name, arg.Type())
+	}
+
+	return value, nil
+}
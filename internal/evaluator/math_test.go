@@ -0,0 +1,151 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestFloatExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1.5", "1.5"},
+		{"2.0", "2.0"},
+		{"-2.5", "-2.5"},
+		{"1.5 + 1.5", "3.0"},
+		{"1 + 0.5", "1.5"},
+		{"0.5 * 4", "2.0"},
+		{"7 / 2.0", "3.5"},
+		{"7 / 2", "3"},
+		{"5.5 % 2", "1.5"},
+		{"0.1 + 0.2 > 0.3", "true"},
+		{"1 < 1.5", "true"},
+		{"2.0 == 2", "true"},
+		{"2.5 != 2.5", "false"},
+		{"let x = 1; x += 0.5; x", "1.5"},
+		{"sort([2, 0.5, 1])", "[0.5, 1, 2]"},
+		{"match (1.5) { 1.5 => true, _ => false }", "true"},
+		{"match (-0.5) { -0.5 => true, _ => false }", "true"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestMathNamespace(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"math.abs(-3)", "3"},
+		{"math.abs(-2.5)", "2.5"},
+		{"math.min(3, 1.5, 2)", "1.5"},
+		{"math.max(3, 1.5, 2)", "3"},
+		{"math.min(4)", "4"},
+		{"math.pow(2, 10)", "1024"},
+		{"math.pow(2, -1)", "0.5"},
+		{"math.pow(9, 0.5)", "3.0"},
+		{"math.sqrt(16)", "4.0"},
+		{"math.floor(2.7)", "2"},
+		{"math.floor(-2.5)", "-3"},
+		{"math.ceil(2.1)", "3"},
+		{"math.round(2.5)", "3"},
+		{"math.round(-2.5)", "-3"},
+		{"math.round(7)", "7"},
+		{"math.round(2.345, 2)", "2.35"},
+		{"math.round(1234, -2)", "1200.0"},
+		{"math.sin(0)", "0.0"},
+		{"math.cos(0)", "1.0"},
+		{"math.tan(0)", "0.0"},
+		{"math.asin(1) * 2 == math.PI", "true"},
+		{"math.acos(1)", "0.0"},
+		{"math.atan(0)", "0.0"},
+		{"math.atan2(1, 1) * 4 == math.PI", "true"},
+		{"math.exp(0)", "1.0"},
+		{"math.log(math.E)", "1.0"},
+		{"math.log2(8)", "3.0"},
+		{"math.log10(1000)", "3.0"},
+		{"math.PI", "3.141592653589793"},
+		{"math.E", "2.718281828459045"},
+		{"let pi = math.PI; let {sqrt} = math; sqrt(4) + pi > 5", "true"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestMathErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1.5 / 0", "division by zero: 1.5 / 0"},
+		{"1 % 0.0", "division by zero: 1 % 0.0"},
+		{"-true", "unknown operator: -BOOLEAN"},
+		{`1.5 + "a"`, "type mismatch: FLOAT + STRING"},
+		{`math.abs("a")`, "argument to `abs` not supported, got STRING"},
+		{"math.max()", "wrong number of arguments. got=0, want at least 1"},
+		{`math.sqrt([])`, "argument to `sqrt` not supported, got ARRAY"},
+		{"math.round(1.5, 1.5)", "argument to `round` not supported, got FLOAT"},
+		{"math.floor(math.sqrt(-1))", "cannot convert NaN to INTEGER"},
+		{"math.random(0)", "random bound must be positive, got 0"},
+		{"math.PI = 3", "cannot mutate frozen HASH"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
+
+func TestSeededRandom(t *testing.T) {
+	input := "[math.random(), math.random(100), math.random(100), math.random()]"
+
+	SetRandomSeed(42)
+	first := testEval(input).Inspect()
+
+	SetRandomSeed(42)
+	second := testEval(input).Inspect()
+
+	if first != second {
+		t.Errorf("same seed produced different numbers. got=%s and %s", first, second)
+	}
+
+	SetRandomSeed(7)
+	if third := testEval(input).Inspect(); third == first {
+		t.Errorf("different seeds produced the same numbers: %s", third)
+	}
+
+	for i := 0; i < 100; i++ {
+		n := testEval("math.random(3)").(*object.Integer).Value
+		if n < 0 || n >= 3 {
+			t.Fatalf("math.random(3) out of range. got=%d", n)
+		}
+
+		f := testEval("math.random()").(*object.Float).Value
+		if f < 0 || f >= 1 {
+			t.Fatalf("math.random() out of range. got=%f", f)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package evaluator
+
+import (
+	"github.com/RafaLopesMelo/monkey-lang/internal/ast"
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func init() {
+	builtins["set_proto"] = &object.Builtin{Fn: builtinSetProto}
+	builtins["get_proto"] = &object.Builtin{Fn: builtinGetProto}
+}
+
+// Calls fn with self bound to the receiver when it was read from a hash or a struct, as in
+// p.norm(). The binding lives in an environment between the function's own and its parameters,
+// so parameters and locals named self shadow it.
+func applyMethod(fn object.Object, receiver object.Object, args []object.Object) object.Object {
+	function, ok := fn.(*object.Function)
+	if !ok {
+		return applyFunction(fn, args)
+	}
+
+	switch receiver.(type) {
+	case *object.Hash, *object.Struct:
+	default:
+		return applyFunction(fn, args)
+	}
+
+	env := object.NewEnclosedEnvironment(function.Env)
+	env.Set("self", receiver)
+
+	method := &object.Function{
+		Parameters: function.Parameters,
+		Body:       function.Body,
+		Env:        env,
+	}
+
+	return applyFunction(method, args)
+}
+
+func evalStructMethod(definition *object.StructType, name string) object.Object {
+	method, ok := definition.Method(name)
+	if !ok {
+		return newError("unknown method %s on struct %s", name, definition.Name)
+	}
+
+	return method
+}
+
+// Defines a method shared by every instance of the struct type, e.g. Point.norm = fn() { ... }
+func evalStructMethodAssignment(definition *object.StructType, name string, node *ast.AssignExpression, env *object.Environment) object.Object {
+	if _, ok := definition.FieldIndex(name); ok {
+		return newError("method %s conflicts with a field of struct %s", name, definition.Name)
+	}
+
+	var current object.Object = NULL
+	if method, ok := definition.Method(name); ok {
+		current = method
+	}
+
+	val := Eval(node.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	val = evalCompoundOperator(node.Operator, current, val)
+	if isError(val) {
+		return val
+	}
+
+	method, ok := val.(*object.Function)
+	if !ok {
+		return newError("methods must be FUNCTION, got %s", val.Type())
+	}
+
+	definition.SetMethod(name, method)
+
+	return method
+}
+
+// Makes the second hash the prototype of the first one, which dot access and indexing fall back to
+// for the keys the first one lacks. null removes the prototype. Returns the first hash
+func builtinSetProto(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	hash, err := hashArgument("set_proto", args[0])
+	if err != nil {
+		return err
+	}
+
+	if hash.Frozen {
+		return newError("cannot mutate frozen %s", hash.Type())
+	}
+
+	if args[1] == NULL {
+		hash.Proto = nil
+		return hash
+	}
+
+	proto, err := hashArgument("set_proto", args[1])
+	if err != nil {
+		return err
+	}
+
+	for ancestor := proto; ancestor != nil; ancestor = ancestor.Proto {
+		if ancestor == hash {
+			return newError("cyclic prototype chain")
+		}
+	}
+
+	hash.Proto = proto
+
+	return hash
+}
+
+func builtinGetProto(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	hash, err := hashArgument("get_proto", args[0])
+	if err != nil {
+		return err
+	}
+
+	if hash.Proto == nil {
+		return NULL
+	}
+
+	return hash.Proto
+}
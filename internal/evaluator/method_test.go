@@ -0,0 +1,112 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+const point = `struct Point { x, y }; Point.norm = fn() { self.x * self.x + self.y * self.y }; `
+
+func TestStructMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{point + `Point(3, 4).norm()`, "25"},
+		{point + `let p = Point(1, 2); p.norm()`, "5"},
+		{point + `Point.scale = fn(k) { Point(self.x * k, self.y * k) }; Point(1, 2).scale(3)`, "Point{x: 3, y: 6}"},
+		{point + `Point.scale = fn(k) { Point(self.x * k, self.y * k) }; Point(1, 2).scale(2).norm()`, "20"},
+		{point + `Point.move = fn(dx) { self.x += dx; self }; let p = Point(0, 0); p.move(2); p.move(3); p.x`, "5"},
+		{point + `Point.sum = fn() { [self.x, self.y] |> reduce(0, (acc, v) => acc + v) }; Point(1, 2).sum()`, "3"},
+		{point + `Point.adder = fn() { fn(v) { self.x + v } }; let add = Point(10, 0).adder(); add(5)`, "15"},
+		{point + `Point.same = fn(self) { self }; Point(1, 2).same(7)`, "7"},
+		{point + `map([Point(1, 0), Point(0, 2)], fn(p) { p.norm() })`, "[1, 4]"},
+		{point + `let p = null; p?.norm()`, "null"},
+		{point + `Point(1, 1)?.norm()`, "2"},
+		{point + `Point.norm = fn() { 0 }; Point(3, 4).norm()`, "0"},
+		{point + `Point.norm`, "fn() {\n(((self.x) * (self.x)) + ((self.y) * (self.y)))\n}"},
+		{point + `freeze(Point(3, 4)).norm()`, "25"},
+		{point + `Point.scale = fn(k) { Point(self.x * k, self.y * k) }; 2 |> Point(1, 2).scale() |> fn(p) { p.norm() }`, "20"},
+		{point + `Point.scale = fn(k) { Point(self.x * k, self.y * k) }; let p = Point(1, 2); 3 |> p.scale()`, "Point{x: 3, y: 6}"},
+		{point + `let {x} = Point(1, 2); x`, "1"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestHashMethods(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`let counter = {"n": 0, "inc": fn() { self.n += 1 }}; counter.inc(); counter.inc(); counter.n`, "2"},
+		{`let user = {"name": "ana", "greet": fn(greeting) { greeting + " " + self.name }}; user.greet("hi")`, "hi ana"},
+		{`let h = {"f": fn() { self }}; same(h.f(), h)`, "true"},
+		{`let c = {"n": 1, "add": fn(x) { self.n + x }}; [c.add(5), 5 |> c.add(), 5 |> c.add]`, "[6, 6, 6]"},
+		{`let c = {"add": fn(x, y) { [self.name, x, y] }, "name": "c"}; 1 |> c.add(2)`, "[c, 1, 2]"},
+		{`let animal = {"speak": fn() { self.name + " makes a sound" }}; let dog = set_proto({"name": "rex"}, animal); dog.speak()`, "rex makes a sound"},
+		{`let animal = {"legs": 4}; let dog = set_proto({"name": "rex"}, animal); [dog.legs, dog["legs"], dog.name]`, "[4, 4, rex]"},
+		{`let animal = {"legs": 4}; let bird = set_proto({"legs": 2}, animal); bird.legs`, "2"},
+		{`let a = {"kind": fn() { "a:" + self.name }}; let b = set_proto({}, a); let c = set_proto({"name": "c"}, b); c.kind()`, "a:c"},
+		{`let base = {"x": 1}; let child = set_proto({}, base); base.x = 2; child.x`, "2"},
+		{`let base = {"x": 1}; let child = set_proto({}, base); child.x = 5; [base.x, child.x]`, "[1, 5]"},
+		{`let base = {"x": 1}; let child = set_proto({}, base); [has(child, "x"), keys(child), child]`, "[false, [], {}]"},
+		{`let base = {"x": 1}; let child = set_proto({}, base); same(get_proto(child), base)`, "true"},
+		{`get_proto({})`, "null"},
+		{`let base = {"x": 1}; let child = set_proto({}, base); set_proto(child, null); child.x`, "null"},
+		{`let base = {"x": 1}; let child = set_proto({}, base); child.y ?? "none"`, "none"},
+		{`let f = fn() { self }; let h = {"f": f}; h["f"]()`, "ERROR: identifier not found: self"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestMethodErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{point + `Point(1, 2).length()`, "unknown field length on struct Point"},
+		{point + `Point.length`, "unknown method length on struct Point"},
+		{point + `Point.x = fn() { 1 }`, "method x conflicts with a field of struct Point"},
+		{point + `Point.size = 1`, "methods must be FUNCTION, got INTEGER"},
+		{point + `Point.size = len`, "methods must be FUNCTION, got BUILTIN"},
+		{point + `Point.norm += 1`, "type mismatch: FUNCTION + INTEGER"},
+		{point + `Point.move = fn() { self.x += 1 }; freeze(Point(1, 2)).move()`, "cannot mutate frozen STRUCT"},
+		{point + `Point.scale = fn(k) { Point(self.x * k, self.y * k) }; Point(1, 2).scale()`, "wrong number of arguments. got=0, want=1"},
+		{`let a = {}; let b = set_proto({}, a); set_proto(a, b)`, "cyclic prototype chain"},
+		{`let a = {}; set_proto(a, a)`, "cyclic prototype chain"},
+		{`set_proto(freeze({}), {})`, "cannot mutate frozen HASH"},
+		{`set_proto({}, 1)`, "argument to `set_proto` not supported, got INTEGER"},
+		{`set_proto([], {})`, "argument to `set_proto` not supported, got ARRAY"},
+		{`get_proto(1)`, "argument to `get_proto` not supported, got INTEGER"},
+		{`set_proto({})`, "wrong number of arguments. got=1, want=2"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
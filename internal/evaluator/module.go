@@ -0,0 +1,192 @@
+package evaluator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/ast"
+	"github.com/RafaLopesMelo/monkey-lang/internal/lexer"
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+	"github.com/RafaLopesMelo/monkey-lang/internal/parser"
+)
+
+// Extension appended to import paths that don't have one
+const ModuleExtension = ".monkey"
+
+// Directories searched, in order, for imports that can't be resolved relative to the importing file
+var SearchPath []string
+
+// Every module is evaluated once and cached by its absolute path
+var modules = map[string]*object.Module{}
+
+// Absolute paths of the modules currently being evaluated, outermost first, used to detect cycles
+var importChain []string
+
+// Evaluates the file at path as the entry module of a program, returning the error that stopped
+// it, if any, or the module itself
+func EvalFile(path string) object.Object {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return newError("module not found: %s", path)
+	}
+
+	module, loadErr := loadModule(abs, "")
+	if loadErr != nil {
+		return loadErr
+	}
+
+	return module
+}
+
+func evalImportStatement(node *ast.ImportStatement, env *object.Environment) object.Object {
+	path, err := resolveModule(node.Path.Value, env.ModulePath())
+	if err != nil {
+		return err
+	}
+
+	module, err := loadModule(path, env.ModulePath())
+	if err != nil {
+		err.Stack = append(err.Stack, node.String())
+		return err
+	}
+
+	env.Set(node.Alias.Value, module)
+
+	return nil
+}
+
+// Finds the file an import refers to. Relative paths are tried against the directory of the
+// importing module first, or the working directory outside of modules, and then SearchPath.
+func resolveModule(path string, from string) (string, *object.Error) {
+	if filepath.Ext(path) == "" {
+		path += ModuleExtension
+	}
+
+	var candidates []string
+
+	if filepath.IsAbs(path) {
+		candidates = append(candidates, path)
+	} else {
+		dir := "."
+		if from != "" {
+			dir = filepath.Dir(from)
+		}
+
+		candidates = append(candidates, filepath.Join(dir, path))
+
+		for _, dir := range SearchPath {
+			candidates = append(candidates, filepath.Join(dir, path))
+		}
+	}
+
+	for _, candidate := range candidates {
+		info, err := os.Stat(candidate)
+		if err != nil || info.IsDir() {
+			continue
+		}
+
+		abs, err := filepath.Abs(candidate)
+		if err != nil {
+			continue
+		}
+
+		return abs, nil
+	}
+
+	chain := currentImportChain(from)
+
+	if len(chain) == 0 {
+		return "", newError("module not found: %s", path)
+	}
+
+	return "", newError("module not found: %s (import chain: %s)", path, strings.Join(chain, " -> "))
+}
+
+// Returns the chain of imports leading to the module at from, which is only missing from
+// importChain when it wasn't loaded as an import itself, e.g. when it's evaluated directly
+func currentImportChain(from string) []string {
+	chain := append([]string{}, importChain...)
+
+	if from != "" && (len(chain) == 0 || chain[len(chain)-1] != from) {
+		chain = append(chain, from)
+	}
+
+	return chain
+}
+
+func loadModule(path string, from string) (*object.Module, *object.Error) {
+	if module, ok := modules[path]; ok {
+		return module, nil
+	}
+
+	chain := currentImportChain(from)
+
+	for _, loading := range chain {
+		if loading == path {
+			return nil, newError("import cycle: %s", strings.Join(append(chain, path), " -> "))
+		}
+	}
+
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, newError("could not read module %s: %s", path, err)
+	}
+
+	p := parser.New(lexer.New(string(source)))
+	program := p.ParseProgram()
+
+	if len(p.Errors()) != 0 {
+		return nil, newError("could not parse module %s: %s", path, strings.Join(p.Errors(), "; "))
+	}
+
+	env := object.NewModuleEnvironment(path, loadPrelude())
+
+	previous := importChain
+	importChain = append(chain, path)
+	result := Eval(program, env)
+	importChain = previous
+
+	if err, ok := result.(*object.Error); ok {
+		return nil, err
+	}
+
+	module := &object.Module{
+		Path:    path,
+		Env:     env,
+		Exports: exportedNames(program),
+	}
+
+	modules[path] = module
+
+	return module, nil
+}
+
+// Collects the names declared by the top level export statements of a module
+func exportedNames(program *ast.Program) map[string]bool {
+	exports := map[string]bool{}
+
+	for _, stmt := range program.Statements {
+		export, ok := stmt.(*ast.ExportStatement)
+		if !ok {
+			continue
+		}
+
+		switch declaration := export.Statement.(type) {
+		case *ast.LetStatement:
+			for _, name := range patternNames(declaration.Name) {
+				exports[name] = true
+			}
+		case *ast.StructStatement:
+			exports[declaration.Name.Value] = true
+		case *ast.EnumStatement:
+			exports[declaration.Name.Value] = true
+
+			for _, variant := range declaration.Variants {
+				exports[variant.Name.Value] = true
+			}
+		}
+	}
+
+	return exports
+}
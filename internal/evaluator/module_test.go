@@ -0,0 +1,225 @@
+package evaluator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestImportModule(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"main.monkey": `
+            import "lib/math" as math;
+            math.double(math.base) + math.offset;
+        `,
+		"lib/math.monkey": `
+            import "helpers" as helpers;
+
+            export let double = fn(x) { helpers.twice(x) };
+            export const base = 20;
+            export let [offset, _] = [1, 2];
+            let hidden = 3;
+        `,
+		"lib/helpers.monkey": `
+            export let twice = fn(x) { x * 2 };
+        `,
+	})
+
+	testIntegerObject(t, testEvalModule(t, dir, "main.monkey"), 41)
+}
+
+func TestImportModuleErrors(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"hidden.monkey":  `import "lib" as lib; lib.hidden;`,
+		"missing.monkey": `import "nope" as nope;`,
+		"nested.monkey":  `import "broken" as broken;`,
+		"broken.monkey":  `import "nope" as nope;`,
+		"cycle.monkey":   `import "a" as a;`,
+		"a.monkey":       `import "b" as b;`,
+		"b.monkey":       `import "a" as a;`,
+		"lib.monkey":     `let hidden = 1; export let visible = 2;`,
+		"syntax.monkey":  `import "invalid" as invalid;`,
+		"invalid.monkey": `let = 1;`,
+		"assign.monkey":  `import "lib" as lib; lib.visible = 3;`,
+	})
+
+	path := func(name string) string {
+		return filepath.Join(dir, name)
+	}
+
+	tests := []struct {
+		file            string
+		expectedMessage string
+	}{
+		{"hidden.monkey", "module " + path("lib.monkey") + " does not export hidden"},
+		{"missing.monkey", "module not found: nope.monkey (import chain: " + path("missing.monkey") + ")"},
+		{"nested.monkey", "module not found: nope.monkey (import chain: " + path("nested.monkey") + " -> " + path("broken.monkey") + ")"},
+		{"cycle.monkey", "import cycle: " + path("cycle.monkey") + " -> " + path("a.monkey") + " -> " + path("b.monkey") + " -> " + path("a.monkey")},
+		{"syntax.monkey", "could not parse module " + path("invalid.monkey") + ": expected pattern, got ="},
+		{"assign.monkey", "member assignment not supported: MODULE"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEvalModule(t, dir, tt.file)
+		errObj, ok := evaluated.(*object.Error)
+
+		if !ok {
+			t.Errorf("no error object returned for %s. got=%T (%+v)", tt.file, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expectedMessage {
+			t.Errorf("wrong error message for %s. got=%q, want=%q", tt.file, errObj.Message, tt.expectedMessage)
+		}
+	}
+}
+
+func TestImportModuleEvaluatedOnce(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"main.monkey": `
+            import "counter" as first;
+            import "other" as other;
+            import "counter" as second;
+
+            first.increment();
+            other.increment();
+            second.count;
+        `,
+		"other.monkey": `
+            import "counter" as counter;
+
+            export let increment = fn() { counter.increment() };
+        `,
+		"counter.monkey": `
+            export let count = 0;
+            export let increment = fn() { count += 1 };
+        `,
+	})
+
+	testIntegerObject(t, testEvalModule(t, dir, "main.monkey"), 2)
+}
+
+func TestImportModuleFromSearchPath(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"main.monkey": `import "strings" as strings; strings.greeting;`,
+	})
+
+	lib := writeModules(t, map[string]string{
+		"strings.monkey": `export let greeting = 5;`,
+	})
+
+	SearchPath = []string{lib}
+	defer func() { SearchPath = nil }()
+
+	testIntegerObject(t, testEvalModule(t, dir, "main.monkey"), 5)
+}
+
+func TestImportModuleErrorStack(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"main.monkey": `
+            import "lib" as lib;
+
+            try { lib.fail() } catch (e) { e.stack }
+        `,
+		"lib.monkey": `export let fail = fn() { 1 + true };`,
+		"eager.monkey": `
+            try { import "failing" as failing; } catch (e) { e.stack }
+        `,
+		"failing.monkey": `1 + true;`,
+	})
+
+	tests := []struct {
+		file     string
+		expected []string
+	}{
+		{"main.monkey", []string{"(lib.fail)()"}},
+		{"eager.monkey", []string{`import "failing" as failing;`}},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEvalModule(t, dir, tt.file)
+		stack, ok := evaluated.(*object.Array)
+
+		if !ok {
+			t.Errorf("object is not array. got=%T (%+v)", evaluated, evaluated)
+			continue
+		}
+
+		if len(stack.Elements) != len(tt.expected) {
+			t.Errorf("stack has wrong length. got=%d, want=%d", len(stack.Elements), len(tt.expected))
+			continue
+		}
+
+		for i, frame := range tt.expected {
+			if stack.Elements[i].Inspect() != frame {
+				t.Errorf("wrong frame %d. got=%q, want=%q", i, stack.Elements[i].Inspect(), frame)
+			}
+		}
+	}
+}
+
+func TestEvalFile(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"ok.monkey":     `export let answer = 42;`,
+		"failed.monkey": `import "missing" as missing;`,
+	})
+
+	evaluated := EvalFile(filepath.Join(dir, "ok.monkey"))
+	module, ok := evaluated.(*object.Module)
+
+	if !ok {
+		t.Fatalf("object is not module. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	answer, ok := module.Get("answer")
+	if !ok {
+		t.Fatalf("module does not export answer")
+	}
+
+	testIntegerObject(t, answer, 42)
+
+	evaluated = EvalFile(filepath.Join(dir, "failed.monkey"))
+	errObj, ok := evaluated.(*object.Error)
+
+	if !ok {
+		t.Fatalf("no error object returned. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	expected := "module not found: missing.monkey (import chain: " + filepath.Join(dir, "failed.monkey") + ")"
+
+	if errObj.Message != expected {
+		t.Errorf("wrong error message. got=%q, want=%q", errObj.Message, expected)
+	}
+}
+
+func writeModules(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, source := range files {
+		path := filepath.Join(dir, name)
+
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("could not create module dir: %s", err)
+		}
+
+		if err := os.WriteFile(path, []byte(source), 0o644); err != nil {
+			t.Fatalf("could not write module: %s", err)
+		}
+	}
+
+	return dir
+}
+
+// Evaluates the body of a module as a program, so tests can inspect the value it produces
+func testEvalModule(t *testing.T, dir string, file string) object.Object {
+	path := filepath.Join(dir, file)
+
+	source, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("could not read module: %s", err)
+	}
+
+	return testEvalWithEnv(string(source), object.NewModuleEnvironment(path, loadPrelude()))
+}
@@ -0,0 +1,119 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestNullLiteral(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`null`, "null"},
+		{`let x = null; x`, "null"},
+		{`null == null`, "true"},
+		{`{"a": 1}["b"] == null`, "true"},
+		{`0 == null`, "false"},
+		{`!null`, "true"},
+		{`if (null) { 1 } else { 2 }`, "2"},
+		{`[1, null]`, "[1, null]"},
+		{`match (null) { null => "none", _ => "some" }`, "none"},
+		{`match (1) { null => "none", _ => "some" }`, "some"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestNullCoalescing(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`null ?? 1`, "1"},
+		{`2 ?? 1`, "2"},
+		{`false ?? 1`, "false"},
+		{`0 ?? 1`, "0"},
+		{`null ?? null ?? 3`, "3"},
+		{`let h = {"a": 1}; h["b"] ?? 5`, "5"},
+		{`let h = {"a": 1}; h["a"] ?? 5`, "1"},
+		{`1 ?? undefined_name`, "1"},
+		{`let calls = 0; let f = fn() { calls += 1 }; 1 ?? f(); calls`, "0"},
+		{`let x = null; x = x ?? 4; x`, "4"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestOptionalChaining(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`let user = {"address": {"city": "Lisbon"}}; user?.address?.city`, "Lisbon"},
+		{`let user = null; user?.address`, "null"},
+		{`let user = null; user?.address.city`, "null"},
+		{`let user = null; user?.address.city[0]()`, "null"},
+		{`let user = {"address": null}; user.address?.city`, "null"},
+		{`let user = {}; user.address?.city ?? "unknown"`, "unknown"},
+		{`let list = [1, 2]; list?.[1]`, "2"},
+		{`let list = null; list?.[1]`, "null"},
+		{`let list = null; list?.[0][1]`, "null"},
+		{`let f = fn(x) { x * 2 }; f?.(4)`, "8"},
+		{`let f = null; f?.(4)`, "null"},
+		{`let h = {}; h["f"]?.(1)`, "null"},
+		{`let calls = 0; let f = null; f?.(fn() { calls += 1 }()); calls`, "0"},
+		{`let calls = 0; let h = null; h?.[fn() { calls += 1 }()]; calls`, "0"},
+		{`let h = {"a": false}; h?.a`, "false"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestOptionalChainingErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`let user = {"address": null}; user?.address.city`, "member access not supported: NULL"},
+		{`null.a`, "member access not supported: NULL"},
+		{`null[0]`, "index operator not supported: NULL"},
+		{`null()`, "not a function: NULL"},
+		{`5?.a`, "member access not supported: INTEGER"},
+		{`let f = 5; f?.()`, "not a function: INTEGER"},
+		{`missing?.a`, "identifier not found: missing"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
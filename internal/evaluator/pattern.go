@@ -0,0 +1,227 @@
+package evaluator
+
+import (
+	"github.com/RafaLopesMelo/monkey-lang/internal/ast"
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+type binding struct {
+	name  string
+	value object.Object
+}
+
+// Destructures val according to pattern, appending the names the pattern introduces to bindings.
+// Nothing is bound to an environment here, so a mismatch never leaves a pattern half applied. env
+// is only read, to resolve the variants and struct types patterns name.
+func destructure(pattern ast.Pattern, val object.Object, bindings []binding, env *object.Environment) ([]binding, *object.Error) {
+	switch pattern := pattern.(type) {
+	case *ast.Identifier:
+		// The "_" wildcard matches anything without binding it
+		if pattern.Value == "_" {
+			return bindings, nil
+		}
+
+		// Naming a variant without values, such as Empty, checks for it instead of binding
+		if variant, ok := unitVariant(pattern.Value, env); ok {
+			if value, ok := val.(*object.EnumValue); !ok || value.Variant != variant {
+				return nil, newError("value %s does not match pattern %s", val.Inspect(), pattern.String())
+			}
+
+			return bindings, nil
+		}
+
+		return append(bindings, binding{name: pattern.Value, value: val}), nil
+	case *ast.ArrayPattern:
+		return destructureArray(pattern, val, bindings, env)
+	case *ast.HashPattern:
+		return destructureHash(pattern, val, bindings, env)
+	case *ast.ConstructorPattern:
+		return destructureConstructor(pattern, val, bindings, env)
+	case *ast.LiteralPattern:
+		expected := Eval(pattern.Value, nil)
+
+		if evalInfixExpression("==", val, expected) != TRUE {
+			return nil, newError("value %s does not match pattern %s", val.Inspect(), pattern.String())
+		}
+
+		return bindings, nil
+	case *ast.AlternativePattern:
+		for _, alternative := range pattern.Alternatives {
+			if matched, err := destructure(alternative, val, bindings, env); err == nil {
+				return matched, nil
+			}
+		}
+
+		return nil, newError("value %s does not match pattern %s", val.Inspect(), pattern.String())
+	default:
+		return nil, newError("unknown pattern: %s", pattern.String())
+	}
+}
+
+func destructureArray(pattern *ast.ArrayPattern, val object.Object, bindings []binding, env *object.Environment) ([]binding, *object.Error) {
+	array, ok := val.(*object.Array)
+	if !ok {
+		return nil, newError("cannot destructure %s as array", val.Type())
+	}
+
+	want := len(pattern.Elements)
+	got := len(array.Elements)
+
+	if pattern.Rest == nil && got != want {
+		return nil, newError("array pattern expects %d elements, got %d", want, got)
+	}
+
+	if pattern.Rest != nil && got < want {
+		return nil, newError("array pattern expects at least %d elements, got %d", want, got)
+	}
+
+	var err *object.Error
+
+	for idx, element := range pattern.Elements {
+		bindings, err = destructure(element, array.Elements[idx], bindings, env)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if pattern.Rest != nil {
+		rest := make([]object.Object, got-want)
+		copy(rest, array.Elements[want:])
+
+		bindings = append(bindings, binding{name: pattern.Rest.Value, value: &object.Array{Elements: rest}})
+	}
+
+	return bindings, nil
+}
+
+func destructureHash(pattern *ast.HashPattern, val object.Object, bindings []binding, env *object.Environment) ([]binding, *object.Error) {
+	if s, ok := val.(*object.Struct); ok {
+		return destructureStruct(pattern, s, bindings, env)
+	}
+
+	hash, ok := val.(*object.Hash)
+	if !ok {
+		return nil, newError("cannot destructure %s as hash", val.Type())
+	}
+
+	var err *object.Error
+
+	for _, pair := range pattern.Pairs {
+		key := patternKey(pair.Key)
+
+		hashKey, ok := object.AsHashable(key)
+		if !ok {
+			return nil, newError("unusable as hash key: %s", key.Type())
+		}
+
+		found, ok := hash.Get(hashKey)
+		if !ok {
+			return nil, newError("key not found in hash: %s", key.Inspect())
+		}
+
+		bindings, err = destructure(pair.Value, found, bindings, env)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return bindings, nil
+}
+
+// Hash patterns match structs by field name, e.g. {x, y: 0} matches Point(1, 0)
+func destructureStruct(pattern *ast.HashPattern, s *object.Struct, bindings []binding, env *object.Environment) ([]binding, *object.Error) {
+	var err *object.Error
+
+	for _, pair := range pattern.Pairs {
+		key, ok := patternKey(pair.Key).(*object.String)
+		if !ok {
+			return nil, newError("struct fields are matched by name, got %s", pair.Key.String())
+		}
+
+		found, ok := s.Get(key.Value)
+		if !ok {
+			return nil, newError("unknown field %s on struct %s", key.Value, s.Definition.Name)
+		}
+
+		bindings, err = destructure(pair.Value, found, bindings, env)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return bindings, nil
+}
+
+// Hash pattern keys are literals, except for identifiers which name a string key
+func patternKey(key ast.Expression) object.Object {
+	if ident, ok := key.(*ast.Identifier); ok {
+		return &object.String{Value: ident.Value}
+	}
+
+	return Eval(key, nil)
+}
+
+// Lists the names a pattern binds when it matches
+func patternNames(pattern ast.Pattern) []string {
+	var names []string
+
+	switch pattern := pattern.(type) {
+	case *ast.Identifier:
+		if pattern.Value != "_" {
+			names = append(names, pattern.Value)
+		}
+	case *ast.ArrayPattern:
+		for _, element := range pattern.Elements {
+			names = append(names, patternNames(element)...)
+		}
+
+		if pattern.Rest != nil {
+			names = append(names, pattern.Rest.Value)
+		}
+	case *ast.HashPattern:
+		for _, pair := range pattern.Pairs {
+			names = append(names, patternNames(pair.Value)...)
+		}
+	case *ast.ConstructorPattern:
+		for _, argument := range pattern.Arguments {
+			names = append(names, patternNames(argument)...)
+		}
+	case *ast.AlternativePattern:
+		for _, alternative := range pattern.Alternatives {
+			names = append(names, patternNames(alternative)...)
+		}
+	}
+
+	return names
+}
+
+// Checks that every constructor a pattern names exists, so a misspelt constructor is reported
+// instead of being taken for a pattern that does not match
+func resolvePatterns(pattern ast.Pattern, env *object.Environment) *object.Error {
+	var children []ast.Pattern
+
+	switch pattern := pattern.(type) {
+	case *ast.ArrayPattern:
+		children = pattern.Elements
+	case *ast.HashPattern:
+		for _, pair := range pattern.Pairs {
+			children = append(children, pair.Value)
+		}
+	case *ast.ConstructorPattern:
+		if _, err := resolveConstructor(pattern, env); err != nil {
+			return err
+		}
+
+		children = pattern.Arguments
+	case *ast.AlternativePattern:
+		children = pattern.Alternatives
+	}
+
+	for _, child := range children {
+		if err := resolvePatterns(child, env); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package evaluator
+
+import (
+	"github.com/RafaLopesMelo/monkey-lang/internal/ast"
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func evalPipeExpression(node *ast.PipeExpression, env *object.Environment) object.Object {
+	left := Eval(node.Left, env)
+	if isError(left) {
+		return left
+	}
+
+	var (
+		fn, receiver object.Object
+		short        bool
+		args         []object.Object
+	)
+
+	// Methods keep their receiver, so x |> c.add(1) is c.add(x, 1) with self bound to c
+	if call, ok := node.Right.(*ast.CallExpression); ok {
+		fn, receiver, short = evalCallee(call.Function, call.Optional, env)
+		if short || isError(fn) {
+			return fn
+		}
+
+		args = evalExpressions(call.Arguments, env)
+		if len(args) == 1 && isError(args[0]) {
+			return args[0]
+		}
+	} else {
+		fn, receiver, short = evalCallee(node.Right, false, env)
+		if short || isError(fn) {
+			return fn
+		}
+	}
+
+	if !isCallable(fn) {
+		return newError("cannot pipe into %s, right side of |> must be a function or a call", fn.Type())
+	}
+
+	result := applyMethod(fn, receiver, append([]object.Object{left}, args...))
+
+	if err, ok := result.(*object.Error); ok {
+		err.Stack = append(err.Stack, node.String())
+	}
+
+	return result
+}
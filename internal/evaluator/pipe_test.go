@@ -0,0 +1,78 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestPipeExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`[1, 2, 3] |> len`, "3"},
+		{`[1, 2, 3] |> len()`, "3"},
+		{`[1, 2, 3, 4] |> filter(fn(x) { x % 2 == 0 }) |> map(fn(x) { x * 10 })`, "[20, 40]"},
+		{`["a", "b"] |> join(",")`, "a,b"},
+		{`1..=10 |> filter(fn(x) { x > 7 }) |> sum`, "27"},
+		{`let double = fn(x) { x * 2 }; 4 |> double |> double`, "16"},
+		{`let add = fn(a, b) { a + b }; 1 + 2 |> add(10)`, "13"},
+		{`5 |> fn(x) { x + 1 }`, "6"},
+		{`let h = {"inc": fn(x) { x + 1 }}; 1 |> h.inc()`, "2"},
+		{`let h = {"inc": fn(x) { x + 1 }}; 1 |> h.inc`, "2"},
+		{`"hello" |> upper() |> len() == 5`, "true"},
+		{`let h = null; 1 |> h?.f()`, "null"},
+		{`let calls = 0; let f = fn(x) { calls += 1; x }; [1] |> reduce(0, fn(a, b) { f(a + b) }); calls`, "1"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestPipeErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`1 |> 2`, "cannot pipe into INTEGER, right side of |> must be a function or a call"},
+		{`let h = {}; 1 |> h.missing()`, "cannot pipe into NULL, right side of |> must be a function or a call"},
+		{`[1] |> "len"`, "cannot pipe into STRING, right side of |> must be a function or a call"},
+		{`1 |> nope()`, "identifier not found: nope"},
+		{`[1] |> map(nope)`, "identifier not found: nope"},
+		{`1 |> len`, "argument to `len` not supported, got INTEGER"},
+		{`1 |> fn(a, b) { a }`, "wrong number of arguments. got=1, want=2"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
+
+func TestPipeErrorStack(t *testing.T) {
+	evaluated := testEval(`let fail = fn(x) { throw "boom" }; 1 |> fail()`)
+
+	errObj, ok := evaluated.(*object.Error)
+	if !ok {
+		t.Fatalf("object is not Error. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	if len(errObj.Stack) != 1 || errObj.Stack[0] != "(1 |> fail())" {
+		t.Errorf("wrong stack. got=%q, want=%q", errObj.Stack, []string{"(1 |> fail())"})
+	}
+}
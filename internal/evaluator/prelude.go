@@ -0,0 +1,49 @@
+package evaluator
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/lexer"
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+	"github.com/RafaLopesMelo/monkey-lang/internal/parser"
+)
+
+// Standard library written in the language itself, available in every global environment
+//
+//go:embed prelude.monkey
+var preludeSource string
+
+var (
+	prelude     *object.Environment
+	preludeOnce sync.Once
+)
+
+// Creates a global environment with the prelude in scope. Its functions are bound as constants in a
+// shared enclosing environment, so programs can shadow them but never change them for anyone else.
+func NewEnvironment() *object.Environment {
+	return object.NewEnclosedEnvironment(loadPrelude())
+}
+
+func loadPrelude() *object.Environment {
+	preludeOnce.Do(func() {
+		p := parser.New(lexer.New(preludeSource))
+		program := p.ParseProgram()
+
+		if len(p.Errors()) != 0 {
+			panic(fmt.Sprintf("could not parse prelude: %s", strings.Join(p.Errors(), "; ")))
+		}
+
+		env := object.NewEnvironment()
+
+		if err, ok := Eval(program, env).(*object.Error); ok {
+			panic(fmt.Sprintf("could not evaluate prelude: %s", err.Message))
+		}
+
+		prelude = env
+	})
+
+	return prelude
+}
@@ -0,0 +1,92 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestPreludeFunctions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`let seen = []; each([1, 2], fn(x) { seen = push(seen, x) }); seen`, "[1, 2]"},
+		{`each([1, 2], fn(x) { x })`, "null"},
+		{`range(0, 4)`, "[0, 1, 2, 3]"},
+		{`range(2, 2)`, "[]"},
+		{`range(3, 1)`, "[]"},
+		{`zip([1, 2, 3], ["a", "b"])`, "[[1, a], [2, b]]"},
+		{`zip([], [1])`, "[]"},
+		{`any([1, 2, 3], fn(x) { x > 2 })`, "true"},
+		{`any([1, 2, 3], fn(x) { x > 3 })`, "false"},
+		{`any([], fn(x) { true })`, "false"},
+		{`all([1, 2, 3], fn(x) { x > 0 })`, "true"},
+		{`all([1, 2, 3], fn(x) { x > 1 })`, "false"},
+		{`all([], fn(x) { false })`, "true"},
+		{`find([1, 2, 3, 4], fn(x) { x > 2 })`, "3"},
+		{`find([1, 2], fn(x) { x > 2 })`, "null"},
+		{`count([1, 2, 3, 4], fn(x) { x > 1 })`, "3"},
+		{`sum([1, 2, 3])`, "6"},
+		{`sum([])`, "0"},
+		{`take([1, 2, 3], 2)`, "[1, 2]"},
+		{`take([1, 2], 5)`, "[1, 2]"},
+		{`drop([1, 2, 3], 2)`, "[3]"},
+		{`drop([1, 2], 5)`, "[]"},
+		{`sum(map(filter(range(1, 6), fn(x) { x % 2 == 1 }), fn(x) { x * x }))`, "35"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated == nil {
+			t.Errorf("%s evaluated to nil", tt.input)
+			continue
+		}
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestPreludeCanBeShadowed(t *testing.T) {
+	input := `
+	let filter = fn(arr, f) { "mine" };
+	[filter([1], fn(x) { x }), count([1, 2], fn(x) { x > 1 })]
+	`
+
+	evaluated := testEval(input)
+
+	if evaluated.Inspect() != "[mine, 1]" {
+		t.Errorf("wrong result. got=%q, want=%q", evaluated.Inspect(), "[mine, 1]")
+	}
+}
+
+func TestPreludeCannotBeReassigned(t *testing.T) {
+	evaluated := testEval(`range = 5`)
+
+	errObj, ok := evaluated.(*object.Error)
+	if !ok {
+		t.Fatalf("object is not Error. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	if errObj.Message != "cannot assign to constant: range" {
+		t.Errorf("wrong error message. got=%q", errObj.Message)
+	}
+
+	testIntegerObject(t, testEval(`len(range(0, 2))`), 2)
+}
+
+func TestPreludeIsNotInBareEnvironment(t *testing.T) {
+	evaluated := testEvalWithEnv(`range`, object.NewEnvironment())
+
+	errObj, ok := evaluated.(*object.Error)
+	if !ok {
+		t.Fatalf("object is not Error. got=%T (%+v)", evaluated, evaluated)
+	}
+
+	if errObj.Message != "identifier not found: range" {
+		t.Errorf("wrong error message. got=%q", errObj.Message)
+	}
+}
@@ -0,0 +1,195 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestRangeExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`0..5`, "0..5"},
+		{`0..=5`, "0..=5"},
+		{`10..0 step -2`, "10..0 step -2"},
+		{`let n = 3; 1..n + 1`, "1..4"},
+		{`to_array(0..5)`, "[0, 1, 2, 3, 4]"},
+		{`to_array(0..=5)`, "[0, 1, 2, 3, 4, 5]"},
+		{`to_array(0..10 step 3)`, "[0, 3, 6, 9]"},
+		{`to_array(0..=9 step 3)`, "[0, 3, 6, 9]"},
+		{`to_array(10..0 step -3)`, "[10, 7, 4, 1]"},
+		{`to_array(5..=1 step -2)`, "[5, 3, 1]"},
+		{`to_array(-2..2)`, "[-2, -1, 0, 1]"},
+		{`to_array(5..5)`, "[]"},
+		{`to_array(5..=5)`, "[5]"},
+		{`to_array(5..0)`, "[]"},
+		{`to_array(0..5 step -1)`, "[]"},
+		{`to_array([1, 2])`, "[1, 2]"},
+		{`let a = [1, 2]; let b = to_array(a); b[0] = 9; a`, "[1, 2]"},
+		{`range(0, 3)`, "[0, 1, 2]"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestRangesAreLazy(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`len(0..1000000000000)`, "1000000000000"},
+		{`len(0..10 step 3)`, "4"},
+		{`len(0..=10 step 5)`, "3"},
+		{`len(10..0)`, "0"},
+		{`(0..1000000000000)[-1]`, "999999999999"},
+		{`(0..10 step 2)[3]`, "6"},
+		{`(0..10)[10]`, "null"},
+		{`(0..10)[-11]`, "null"},
+		{`(0..1000000000000)[5:8]`, "5..8"},
+		{`(0..20 step 5)[1:]`, "5..20 step 5"},
+		{`to_array((0..=20 step 5)[1:3])`, "[5, 10]"},
+		{`(0..10)[8:2]`, "0..0"},
+		{`first(0..1000000000000)`, "0"},
+		{`last(0..1000000000000)`, "999999999999"},
+		{`last(0..=10 step 3)`, "9"},
+		{`first(0..0)`, "null"},
+		{`last(0..0)`, "null"},
+		{`rest(0..1000000000000)`, "1..1000000000000"},
+		{`rest(0..0)`, "null"},
+		{`contains(0..1000000000000, 999999999999)`, "true"},
+		{`contains(0..1000000000000, 1000000000000)`, "false"},
+		{`contains(0..=10 step 2, 4)`, "true"},
+		{`contains(0..=10 step 2, 5)`, "false"},
+		{`contains(10..0 step -2, 4)`, "true"},
+		{`contains(0..10, 4.0)`, "true"},
+		{`contains(0..10, 4.5)`, "false"},
+		{`contains(0..10, "4")`, "false"},
+		{`index_of(0..1000000000000 step 5, 50)`, "10"},
+		{`index_of(0..10, 10)`, "-1"},
+		{`len(0..9223372036854775807 step 2)`, "4611686018427387904"},
+		{`len(-9223372036854775807..9223372036854775807 step 4)`, "4611686018427387904"},
+		{`len(9223372036854775807..=-9223372036854775807 step -9223372036854775807)`, "3"},
+		{`(0..9223372036854775807 step 2)[-1]`, "9223372036854775806"},
+		{`rest(0..9223372036854775807 step 2)`, "2..9223372036854775807 step 2"},
+		{`rest(9223372036854775807..=9223372036854775807)`, "9223372036854775807..9223372036854775807"},
+		{`let r = 9223372036854775807..=9223372036854775807; r[1:]`, "9223372036854775807..9223372036854775807"},
+		{`contains(0..9223372036854775807, -9223372036854775807)`, "false"},
+		{`index_of(-9223372036854775807..9223372036854775807 step 2, 9223372036854775805)`, "9223372036854775806"},
+		{`slice(0..1000000000000, 5, 8)`, "[5, 6, 7]"},
+		{`slice(0..1000000000000, -2)`, "[999999999998, 999999999999]"},
+		{`join(0..3, ",")`, "0,1,2"},
+		{`chunk(0..5, 2)`, "[[0, 1], [2, 3], [4]]"},
+		{`chunk(0..3, 9223372036854775807)`, "[[0, 1, 2]]"},
+		{`unique(0..3)`, "[0, 1, 2]"},
+		{`flatten(0..3)`, "[0, 1, 2]"},
+		{`group_by(0..4, fn(x) { x % 2 })`, "{0: [0, 2], 1: [1, 3]}"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestRangeIteration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`map(0..4, fn(x) { x * x })`, "[0, 1, 4, 9]"},
+		{`filter(1..=10, fn(x) { x % 3 == 0 })`, "[3, 6, 9]"},
+		{`reduce(1..=100, 0, fn(total, x) { total + x })`, "5050"},
+		{`sum(1..=10)`, "55"},
+		{`let total = 0; each(0..5, fn(x) { total += x }); total`, "10"},
+		{`take(0..1000000000000, 3)`, "[0, 1, 2]"},
+		{`drop(0..10, 7)`, "7..10"},
+		{`zip(0..3, ["a", "b", "c"])`, "[[0, a], [1, b], [2, c]]"},
+		{`reverse(0..3)`, "[2, 1, 0]"},
+		{`concat(0..2, 5..=6)`, "[0, 1, 5, 6]"},
+		{`map(0..3, fn(x) { (0..x)[-1] })`, "[null, 0, 1]"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestRangeEquality(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{`0..3 == 0..3`, true},
+		{`0..3 == 0..=2`, true},
+		{`0..10 step 3 == 0..=9 step 3`, true},
+		{`5..5 == 1..0`, true},
+		{`0..=0 == 0..1 step 5`, true},
+		{`0..3 == 0..4`, false},
+		{`0..4 step 2 == 0..4`, false},
+		{`0..3 != 1..3`, true},
+		{`0..3 == [0, 1, 2]`, false},
+		{`same(0..3, 0..=2)`, true},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+}
+
+func TestRangeErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`0..10 step 0`, "range step must not be zero"},
+		{`0.."a"`, "range bounds must be INTEGER, got STRING"},
+		{`1.5..3`, "range bounds must be INTEGER, got FLOAT"},
+		{`0..3 step true`, "range bounds must be INTEGER, got BOOLEAN"},
+		{`let r = 0..3; r[0] = 5`, "index assignment not supported: RANGE"},
+		{`to_array(5)`, "argument to `to_array` not supported, got INTEGER"},
+		{`-9223372036854775807..9223372036854775807`, "range -9223372036854775807..9223372036854775807 has too many elements"},
+		{`9223372036854775807..=-9223372036854775807 step -1`, "range 9223372036854775807..=-9223372036854775807 step -1 has too many elements"},
+		{`to_array(0..9223372036854775807 step 2)`, "range 0..9223372036854775807 step 2 is too long to turn into an array"},
+		{`to_array(0..3000000000000)`, "range 0..3000000000000 is too long to turn into an array"},
+		{`sort(0..3000000000000)`, "range 0..3000000000000 is too long to turn into an array"},
+		{`reverse(0..3000000000000)`, "range 0..3000000000000 is too long to turn into an array"},
+		{`slice(0..3000000000000, 1)`, "range 0..3000000000000 is too long to turn into an array"},
+		{`concat([1], 0..3000000000000)`, "range 0..3000000000000 is too long to turn into an array"},
+		{`join(0..3000000000000, "")`, "range 0..3000000000000 is too long to turn into an array"},
+		{`map(0..17000000, fn(x) { 0 })`, "range 0..17000000 is too long to turn into an array"},
+		{`chunk(0..17000000, 2)`, "range 0..17000000 is too long to turn into an array"},
+		{`flatten(0..3000000000000)`, "range 0..3000000000000 is too long to turn into an array"},
+		{`unique(0..3000000000000)`, "range 0..3000000000000 is too long to turn into an array"},
+		{`from_entries(0..3000000000000)`, "entry must be a [key, value] pair, got 0"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestStringIndexExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected any
+	}{
+		{`"monkey"[0]`, "m"},
+		{`"monkey"[5]`, "y"},
+		{`"monkey"[-1]`, "y"},
+		{`"héllo"[1]`, "é"},
+		{`"日本語"[-1]`, "語"},
+		{`"monkey"[6]`, nil},
+		{`"monkey"[-7]`, nil},
+		{`""[0]`, nil},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		expected, ok := tt.expected.(string)
+		if !ok {
+			testNullObject(t, evaluated)
+			continue
+		}
+
+		str, ok := evaluated.(*object.String)
+		if !ok {
+			t.Errorf("%s: object is not String. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if str.Value != expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, str.Value, expected)
+		}
+	}
+}
+
+func TestSliceExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`[1, 2, 3, 4][1:3]`, "[2, 3]"},
+		{`[1, 2, 3, 4][2:]`, "[3, 4]"},
+		{`[1, 2, 3, 4][:2]`, "[1, 2]"},
+		{`[1, 2, 3, 4][:]`, "[1, 2, 3, 4]"},
+		{`[1, 2, 3, 4][-2:]`, "[3, 4]"},
+		{`[1, 2, 3, 4][:-1]`, "[1, 2, 3]"},
+		{`[1, 2, 3, 4][3:1]`, "[]"},
+		{`[1, 2, 3, 4][2:100]`, "[3, 4]"},
+		{`[1, 2, 3, 4][-100:1]`, "[1]"},
+		{`let i = 1; [1, 2, 3, 4][i:i + 2]`, "[2, 3]"},
+		{`let a = [1, 2]; let b = a[:]; b[0] = 9; a`, "[1, 2]"},
+		{`"monkey"[2:]`, "nkey"},
+		{`"monkey"[1:3]`, "on"},
+		{`"monkey"[:-3]`, "mon"},
+		{`"héllo"[1:3]`, "él"},
+		{`"monkey"[4:2]`, ""},
+		{`let s = "monkey"; s[0] + s[-1]`, "my"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestSliceErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`{"a": 1}[0:1]`, "slice operator not supported: HASH"},
+		{`5[0:1]`, "slice operator not supported: INTEGER"},
+		{`[1, 2][0:"a"]`, "slice index must be INTEGER, got STRING"},
+		{`"ab"[true:]`, "slice index must be INTEGER, got BOOLEAN"},
+		{`"ab"["a"]`, "index operator not supported: STRING"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
+
+func TestStrictIndexing(t *testing.T) {
+	Strict = true
+	defer func() { Strict = false }()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`[1, 2, 3][3]`, "index out of range: 3 (length 3)"},
+		{`[1, 2, 3][-4]`, "index out of range: -4 (length 3)"},
+		{`[][0]`, "index out of range: 0 (length 0)"},
+		{`"ab"[2]`, "index out of range: 2 (length 2)"},
+		{`[1, 2, 3][1:4]`, "slice index out of range: 4 (length 3)"},
+		{`"abc"[-4:]`, "slice index out of range: -4 (length 3)"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+
+	testIntegerObject(t, testEval(`[1, 2, 3][-1]`), 3)
+	testIntegerObject(t, testEval(`len([1, 2, 3][0:3])`), 3)
+	testIntegerObject(t, testEval(`len("abc"[-3:])`), 3)
+}
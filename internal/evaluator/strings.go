@@ -0,0 +1,336 @@
+package evaluator
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+// Longest string, in characters, that repeat and the padding builtins build, so huge counts are
+// errors rather than exhausting memory
+const maxStringLength = 1 << 24
+
+// String builtins work on runes rather than bytes, so positions and lengths match what is printed
+func init() {
+	stringBuiltins := map[string]object.BuiltinFunction{
+		"split":       builtinSplit,
+		"join":        builtinJoin,
+		"trim":        stringTransform("trim", strings.TrimSpace),
+		"upper":       stringTransform("upper", strings.ToUpper),
+		"lower":       stringTransform("lower", strings.ToLower),
+		"replace":     builtinReplace,
+		"starts_with": stringPredicate("starts_with", strings.HasPrefix),
+		"ends_with":   stringPredicate("ends_with", strings.HasSuffix),
+		"repeat":      builtinRepeat,
+		"pad_left":    stringPadding("pad_left", true),
+		"pad_right":   stringPadding("pad_right", false),
+		"chars":       builtinChars,
+		"substr":      builtinSubstr,
+	}
+
+	for name, fn := range stringBuiltins {
+		builtins[name] = &object.Builtin{Fn: fn}
+	}
+}
+
+func builtinSplit(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	str, sep, err := twoStringArguments("split", args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	return stringsToArray(strings.Split(str, sep))
+}
+
+// Joins the elements of an array, printing the ones that aren't strings as they are inspected
+func builtinJoin(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	seq, err := sequenceArgument("join", args[0])
+	if err != nil {
+		return err
+	}
+
+	sep, err := stringArgument("join", args[1])
+	if err != nil {
+		return err
+	}
+
+	if err := rangeLengthError(args[0], seq.Len()); err != nil {
+		return err
+	}
+
+	var out strings.Builder
+
+	for idx := range seq.Len() {
+		if idx > 0 {
+			out.WriteString(sep)
+		}
+
+		element := seq.At(idx)
+
+		if str, ok := element.(*object.String); ok {
+			out.WriteString(str.Value)
+		} else {
+			out.WriteString(element.Inspect())
+		}
+	}
+
+	return &object.String{Value: out.String()}
+}
+
+func stringTransform(name string, transform func(string) string) object.BuiltinFunction {
+	return func(args ...object.Object) object.Object {
+		if len(args) != 1 {
+			return newError("wrong number of arguments. got=%d, want=1", len(args))
+		}
+
+		str, err := stringArgument(name, args[0])
+		if err != nil {
+			return err
+		}
+
+		return &object.String{Value: transform(str)}
+	}
+}
+
+func stringPredicate(name string, predicate func(string, string) bool) object.BuiltinFunction {
+	return func(args ...object.Object) object.Object {
+		if len(args) != 2 {
+			return newError("wrong number of arguments. got=%d, want=2", len(args))
+		}
+
+		str, other, err := twoStringArguments(name, args[0], args[1])
+		if err != nil {
+			return err
+		}
+
+		return nativeBoolToBooleanObject(predicate(str, other))
+	}
+}
+
+// Replaces every occurrence of the second argument with the third one
+func builtinReplace(args ...object.Object) object.Object {
+	if len(args) != 3 {
+		return newError("wrong number of arguments. got=%d, want=3", len(args))
+	}
+
+	str, old, err := twoStringArguments("replace", args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	replacement, err := stringArgument("replace", args[2])
+	if err != nil {
+		return err
+	}
+
+	return &object.String{Value: strings.ReplaceAll(str, old, replacement)}
+}
+
+func builtinRepeat(args ...object.Object) object.Object {
+	if len(args) != 2 {
+		return newError("wrong number of arguments. got=%d, want=2", len(args))
+	}
+
+	str, err := stringArgument("repeat", args[0])
+	if err != nil {
+		return err
+	}
+
+	count, err := integerArgument("repeat", args[1])
+	if err != nil {
+		return err
+	}
+
+	if count < 0 {
+		return newError("repeat count must not be negative, got %d", count)
+	}
+
+	if length := int64(utf8.RuneCountInString(str)); length > 0 && count > maxStringLength/length {
+		return newError("result of `repeat` would be longer than %d characters", maxStringLength)
+	}
+
+	return &object.String{Value: strings.Repeat(str, int(count))}
+}
+
+// Pads the string up to width runes, with spaces or with the optional third argument repeated
+func stringPadding(name string, left bool) object.BuiltinFunction {
+	return func(args ...object.Object) object.Object {
+		if len(args) != 2 && len(args) != 3 {
+			return newError("wrong number of arguments. got=%d, want=2 or 3", len(args))
+		}
+
+		str, err := stringArgument(name, args[0])
+		if err != nil {
+			return err
+		}
+
+		width, err := integerArgument(name, args[1])
+		if err != nil {
+			return err
+		}
+
+		pad := " "
+
+		if len(args) == 3 {
+			pad, err = stringArgument(name, args[2])
+			if err != nil {
+				return err
+			}
+
+			if pad == "" {
+				return newError("padding for `%s` must not be empty", name)
+			}
+		}
+
+		if width > maxStringLength {
+			return newError("result of `%s` would be longer than %d characters", name, maxStringLength)
+		}
+
+		missing := int(width) - utf8.RuneCountInString(str)
+		if missing <= 0 {
+			return &object.String{Value: str}
+		}
+
+		padRunes := []rune(strings.Repeat(pad, missing/utf8.RuneCountInString(pad)+1))
+		padding := string(padRunes[:missing])
+
+		if left {
+			return &object.String{Value: padding + str}
+		}
+
+		return &object.String{Value: str + padding}
+	}
+}
+
+func builtinChars(args ...object.Object) object.Object {
+	if len(args) != 1 {
+		return newError("wrong number of arguments. got=%d, want=1", len(args))
+	}
+
+	str, err := stringArgument("chars", args[0])
+	if err != nil {
+		return err
+	}
+
+	chars := []string{}
+	for _, char := range str {
+		chars = append(chars, string(char))
+	}
+
+	return stringsToArray(chars)
+}
+
+// Returns the runes from start, counting from the end when negative, up to the optional length
+func builtinSubstr(args ...object.Object) object.Object {
+	if len(args) != 2 && len(args) != 3 {
+		return newError("wrong number of arguments. got=%d, want=2 or 3", len(args))
+	}
+
+	str, err := stringArgument("substr", args[0])
+	if err != nil {
+		return err
+	}
+
+	runes := []rune(str)
+
+	position, err := integerArgument("substr", args[1])
+	if err != nil {
+		return err
+	}
+
+	start := clampPosition(position, int64(len(runes)))
+	end := int64(len(runes))
+
+	if len(args) == 3 {
+		length, err := integerArgument("substr", args[2])
+		if err != nil {
+			return err
+		}
+
+		if length < 0 {
+			return newError("substr length must not be negative, got %d", length)
+		}
+
+		// Compared before adding, since start+length can overflow
+		if length < end-start {
+			end = start + length
+		}
+	}
+
+	return &object.String{Value: string(runes[start:end])}
+}
+
+// Position of the first occurrence of substr in runes, or -1
+func stringIndexOf(str *object.String, substr object.Object) object.Object {
+	sub, err := stringArgument("index_of", substr)
+	if err != nil {
+		return err
+	}
+
+	idx := strings.Index(str.Value, sub)
+	if idx == -1 {
+		return &object.Integer{Value: -1}
+	}
+
+	return &object.Integer{Value: int64(utf8.RuneCountInString(str.Value[:idx]))}
+}
+
+func stringContains(str *object.String, substr object.Object) object.Object {
+	sub, err := stringArgument("contains", substr)
+	if err != nil {
+		return err
+	}
+
+	return nativeBoolToBooleanObject(strings.Contains(str.Value, sub))
+}
+
+func stringsToArray(values []string) *object.Array {
+	elements := make([]object.Object, len(values))
+
+	for idx, value := range values {
+		elements[idx] = &object.String{Value: value}
+	}
+
+	return &object.Array{Elements: elements}
+}
+
+func stringArgument(name string, arg object.Object) (string, *object.Error) {
+	str, ok := arg.(*object.String)
+	if !ok {
+		return "", newError("argument to `%s` not supported, got %s", name, arg.Type())
+	}
+
+	return str.Value, nil
+}
+
+func twoStringArguments(name string, first object.Object, second object.Object) (string, string, *object.Error) {
+	a, err := stringArgument(name, first)
+	if err != nil {
+		return "", "", err
+	}
+
+	b, err := stringArgument(name, second)
+	if err != nil {
+		return "", "", err
+	}
+
+	return a, b, nil
+}
+
+func integerArgument(name string, arg object.Object) (int64, *object.Error) {
+	integer, ok := arg.(*object.Integer)
+	if !ok {
+		return 0, newError("argument to `%s` not supported, got %s", name, arg.Type())
+	}
+
+	return integer.Value, nil
+}
@@ -0,0 +1,105 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestStringBuiltins(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`split("a,b,,c", ",")`, "[a, b, , c]"},
+		{`split("héllo", "")`, "[h, é, l, l, o]"},
+		{`split("abc", "-")`, "[abc]"},
+		{`join(["a", "b", "c"], ", ")`, "a, b, c"},
+		{`join([1, true, "x"], "-")`, "1-true-x"},
+		{`join([], ",")`, ""},
+		{`trim("  hi  ")`, "hi"},
+		{`upper("héllo")`, "HÉLLO"},
+		{`lower("ÀÉÎ")`, "àéî"},
+		{`replace("a-b-c", "-", "+")`, "a+b+c"},
+		{`replace("abc", "x", "y")`, "abc"},
+		{`starts_with("monkey", "mon")`, "true"},
+		{`starts_with("monkey", "key")`, "false"},
+		{`ends_with("monkey", "key")`, "true"},
+		{`ends_with("monkey", "mon")`, "false"},
+		{`contains("日本語", "本")`, "true"},
+		{`contains("abc", "d")`, "false"},
+		{`index_of("日本語", "語")`, "2"},
+		{`index_of("abc", "d")`, "-1"},
+		{`repeat("ab", 3)`, "ababab"},
+		{`repeat("ab", 0)`, ""},
+		{`pad_left("7", 3)`, "  7"},
+		{`pad_left("7", 3, "0")`, "007"},
+		{`pad_left("ü", 4, "ab")`, "abaü"},
+		{`pad_right("ab", 4, "é")`, "abéé"},
+		{`pad_right("abc", 2)`, "abc"},
+		{`chars("añb")`, "[a, ñ, b]"},
+		{`chars("")`, "[]"},
+		{`substr("héllo", 1, 3)`, "éll"},
+		{`substr("héllo", 2)`, "llo"},
+		{`substr("héllo", -2)`, "lo"},
+		{`substr("héllo", 3, 10)`, "lo"},
+		{`substr("héllo", 10)`, ""},
+		{`substr("abc", 1, 9223372036854775807)`, "bc"},
+		{`repeat("", 9223372036854775807)`, ""},
+		{`len("héllo")`, "5"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated == nil {
+			t.Errorf("%s evaluated to nil", tt.input)
+			continue
+		}
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestStringBuiltinErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`split(1, ",")`, "argument to `split` not supported, got INTEGER"},
+		{`split("a")`, "wrong number of arguments. got=1, want=2"},
+		{`join("abc", ",")`, "argument to `join` not supported, got STRING"},
+		{`trim([])`, "argument to `trim` not supported, got ARRAY"},
+		{`upper(1)`, "argument to `upper` not supported, got INTEGER"},
+		{`replace("a", "b", 1)`, "argument to `replace` not supported, got INTEGER"},
+		{`starts_with("a", true)`, "argument to `starts_with` not supported, got BOOLEAN"},
+		{`contains("a", 1)`, "argument to `contains` not supported, got INTEGER"},
+		{`index_of("a", [])`, "argument to `index_of` not supported, got ARRAY"},
+		{`repeat("a", "b")`, "argument to `repeat` not supported, got STRING"},
+		{`repeat("a", -1)`, "repeat count must not be negative, got -1"},
+		{`repeat("ab", 9223372036854775807)`, "result of `repeat` would be longer than 16777216 characters"},
+		{`repeat("ab", 8388609)`, "result of `repeat` would be longer than 16777216 characters"},
+		{`pad_left("a", 9223372036854775807)`, "result of `pad_left` would be longer than 16777216 characters"},
+		{`pad_right("a", 16777217, "xy")`, "result of `pad_right` would be longer than 16777216 characters"},
+		{`pad_left("a", 3, "")`, "padding for `pad_left` must not be empty"},
+		{`pad_right("a")`, "wrong number of arguments. got=1, want=2 or 3"},
+		{`chars(1)`, "argument to `chars` not supported, got INTEGER"},
+		{`substr("abc", 1, -1)`, "substr length must not be negative, got -1"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package evaluator
+
+import (
+	"github.com/RafaLopesMelo/monkey-lang/internal/ast"
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+// Binds the struct type, which doubles as the constructor of its instances
+func evalStructStatement(node *ast.StructStatement, env *object.Environment) object.Object {
+	name := node.Name.Value
+
+	if env.IsConstInScope(name) {
+		return newError("cannot redeclare constant: %s", name)
+	}
+
+	fields := make([]string, len(node.Fields))
+	for idx, field := range node.Fields {
+		fields[idx] = field.Value
+	}
+
+	env.Set(name, &object.StructType{Name: name, Fields: fields})
+
+	return nil
+}
+
+// Builds an instance from one argument per field, in declaration order
+func newStruct(definition *object.StructType, args []object.Object) object.Object {
+	if len(args) != len(definition.Fields) {
+		return newError("wrong number of arguments. got=%d, want=%d", len(args), len(definition.Fields))
+	}
+
+	values := make([]object.Object, len(args))
+	copy(values, args)
+
+	return &object.Struct{Definition: definition, Values: values}
+}
+
+// Fields take precedence over the methods of the struct type
+func evalStructField(s *object.Struct, field string) object.Object {
+	if value, ok := s.Get(field); ok {
+		return value
+	}
+
+	if method, ok := s.Definition.Method(field); ok {
+		return method
+	}
+
+	return newError("unknown field %s on struct %s", field, s.Definition.Name)
+}
+
+func evalStructFieldAssignment(s *object.Struct, field string, node *ast.AssignExpression, env *object.Environment) object.Object {
+	if s.Frozen {
+		return newError("cannot mutate frozen %s", s.Type())
+	}
+
+	current, ok := s.Get(field)
+	if !ok {
+		return newError("unknown field %s on struct %s", field, s.Definition.Name)
+	}
+
+	val := Eval(node.Value, env)
+	if isError(val) {
+		return val
+	}
+
+	val = evalCompoundOperator(node.Operator, current, val)
+	if isError(val) {
+		return val
+	}
+
+	s.Set(field, val)
+
+	return val
+}
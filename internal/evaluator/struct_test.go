@@ -0,0 +1,139 @@
+package evaluator
+
+import (
+	"testing"
+
+	"github.com/RafaLopesMelo/monkey-lang/internal/object"
+)
+
+func TestStructs(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`struct Point { x, y }; Point(1, 2)`, "Point{x: 1, y: 2}"},
+		{`struct Point { x, y }; Point`, "struct Point { x, y }"},
+		{`struct Unit {}; Unit()`, "Unit{}"},
+		{`struct Point { x, y }; let p = Point(1, 2); p.x + p.y`, "3"},
+		{`struct User { name, tags }; User("ana", ["admin"]).tags[0]`, "admin"},
+		{`struct Line { from, to }; struct Point { x, y }; Line(Point(0, 0), Point(3, 4)).to.y`, "4"},
+		{`struct Point { x, y }; let p = Point(1, 2); p.x = 10; p`, "Point{x: 10, y: 2}"},
+		{`struct Point { x, y }; let p = Point(1, 2); p.y += 5; p.y`, "7"},
+		{`struct Point { x, y }; let p = Point(1, 2); let q = p; q.x = 9; p.x`, "9"},
+		{`struct Counter { n }; let c = Counter(0); each(0..3, fn(_) { c.n += 1 }); c.n`, "3"},
+		{`struct Point { x, y }; map([[1, 2], [3, 4]], fn([x, y]) { Point(x, y) })`, "[Point{x: 1, y: 2}, Point{x: 3, y: 4}]"},
+		{`struct Box { value }; map([1, 2], Box)`, "[Box{value: 1}, Box{value: 2}]"},
+		{`struct Box { value }; let b = null; b?.value`, "null"},
+		{`struct Point { x, y }; let {x, y: b} = Point(1, 2); [x, b]`, "[1, 2]"},
+		{`struct Point { x, y }; match (Point(0, 5)) { {x: 0, y} => y, _ => -1 }`, "5"},
+		{`struct Point { x, y }; match (Point(1, 5)) { {x: 0, y} => y, _ => -1 }`, "-1"},
+		{`struct Point { x, y }; match (Point(1, 5)) { {z} => z, _ => -1 }`, "-1"},
+		{`let make = fn() { struct Local { a }; Local(1) }; make().a`, "1"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestExportStruct(t *testing.T) {
+	dir := writeModules(t, map[string]string{
+		"main.monkey": `
+            import "geometry" as geometry;
+            let p = geometry.Point(1, 2);
+            [p, geometry.origin.x, p == geometry.Point(1, 2)];
+        `,
+		"geometry.monkey": `
+            export struct Point { x, y }
+            export let origin = Point(0, 0);
+        `,
+	})
+
+	evaluated := testEvalModule(t, dir, "main.monkey")
+
+	if evaluated.Inspect() != "[Point{x: 1, y: 2}, 0, true]" {
+		t.Errorf("wrong result. got=%q", evaluated.Inspect())
+	}
+}
+
+func TestStructEquality(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{`struct Point { x, y }; Point(1, 2) == Point(1, 2)`, true},
+		{`struct Point { x, y }; Point(1, 2) == Point(1, 2.0)`, true},
+		{`struct Point { x, y }; Point(1, 2) == Point(2, 1)`, false},
+		{`struct Point { x, y }; Point(1, [2]) == Point(1, [2])`, true},
+		{`struct Point { x, y }; struct Vector { x, y }; Point(1, 2) == Vector(1, 2)`, false},
+		{`struct Point { x, y }; Point(1, 2) == {"x": 1, "y": 2}`, false},
+		{`struct Point { x, y }; Point(1, 2) != Point(1, 3)`, true},
+		{`struct Point { x, y }; same(Point(1, 2), Point(1, 2))`, false},
+		{`struct Point { x, y }; let p = Point(1, 2); same(p, p)`, true},
+		{`struct Point { x, y }; Point == Point`, true},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+		testBooleanObject(t, evaluated, tt.expected)
+	}
+}
+
+func TestFrozenStructs(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`struct Point { x, y }; let h = {freeze(Point(1, 2)): "origin"}; h[freeze(Point(1, 2))]`, "origin"},
+		{`struct Point { x, y }; let h = {freeze(Point(1, 2)): "a"}; h[freeze(Point(2, 1))]`, "null"},
+		{`struct Box { items }; let b = freeze(Box([1])); b.items[0] = 2`, "ERROR: cannot mutate frozen ARRAY"},
+		{`struct Point { x, y }; let p = freeze(Point(1, 2)); p.x = 5`, "ERROR: cannot mutate frozen STRUCT"},
+		{`struct Point { x, y }; {Point(1, 2): 1}`, "ERROR: unusable as hash key: STRUCT"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestStructErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`struct Point { x, y }; Point(1)`, "wrong number of arguments. got=1, want=2"},
+		{`struct Point { x, y }; Point(1, 2, 3)`, "wrong number of arguments. got=3, want=2"},
+		{`struct Point { x, y }; Point(1, 2).z`, "unknown field z on struct Point"},
+		{`struct Point { x, y }; let p = Point(1, 2); p.z = 3`, "unknown field z on struct Point"},
+		{`struct Point { x, y }; let p = Point(1, 2); p.z += 3`, "unknown field z on struct Point"},
+		{`struct Point { x, y }; Point(1, 2)["x"]`, "index operator not supported: STRUCT"},
+		{`struct Point { x, y }; Point.x`, "unknown method x on struct Point"},
+		{`struct Point { x, y }; let [a, b] = Point(1, 2)`, "cannot destructure STRUCT as array"},
+		{`struct Point { x, y }; let {z} = Point(1, 2)`, "unknown field z on struct Point"},
+		{`struct Point { x, y }; let {1: a} = Point(1, 2)`, "struct fields are matched by name, got 1"},
+		{`const Point = 1; struct Point { x }`, "cannot redeclare constant: Point"},
+		{`struct Point { x, y }; json_encode(Point(1, 2))`, "cannot encode STRUCT as JSON"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		errObj, ok := evaluated.(*object.Error)
+		if !ok {
+			t.Errorf("%s: object is not Error. got=%T (%+v)", tt.input, evaluated, evaluated)
+			continue
+		}
+
+		if errObj.Message != tt.expected {
+			t.Errorf("%s: wrong error message. got=%q, want=%q", tt.input, errObj.Message, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package evaluator
+
+import "testing"
+
+func TestTernaryExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`true ? 1 : 2`, "1"},
+		{`false ? 1 : 2`, "2"},
+		{`null ? 1 : 2`, "2"},
+		{`0 ? 1 : 2`, "1"},
+		{`let x = -5; x > 0 ? x : -x`, "5"},
+		{`let sign = fn(x) { x < 0 ? -1 : x == 0 ? 0 : 1 }; [sign(-3), sign(0), sign(8)]`, "[-1, 0, 1]"},
+		{`let n = 3; "item" + (n == 1 ? "" : "s")`, "items"},
+		{`let calls = 0; let f = fn() { calls += 1 }; true ? 1 : f(); false ? f() : 2; calls`, "0"},
+		{`let x = true ? 1 : undefined_name; x`, "1"},
+		{`[1, 2, 3] |> len() > 2 ? "long" : "short"`, "long"},
+		{`let h = null; h?.a ?? false ? "yes" : "no"`, "no"},
+		{`map(0..4, fn(x) { x % 2 == 0 ? "even" : "odd" })`, "[even, odd, even, odd]"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
+
+func TestIfAsExpression(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`let grade = fn(n) { if (n > 89) { "A" } else if (n > 79) { "B" } else if (n > 69) { "C" } else { "F" } }; map([95, 85, 75, 10], grade)`, "[A, B, C, F]"},
+		{`let x = 15; if (x < 10) { "small" } else if (x < 20) { "medium" }`, "medium"},
+		{`let x = 25; if (x < 10) { "small" } else if (x < 20) { "medium" }`, "null"},
+		{`1 + if (true) { 2 } else { 3 }`, "3"},
+		{`let x = if (false) { 1 } else { 2 }; x * 10`, "20"},
+		{`[if (true) { "a" } else { "b" }, if (false) { 1 }]`, "[a, null]"},
+		{`let f = fn(x) { if (x > 0) { return "positive" } else if (x < 0) { return "negative" }; "zero" }; [f(1), f(-1), f(0)]`, "[positive, negative, zero]"},
+		{`let x = if (true) {}; x`, "null"},
+		{`let x = if (false) { 1 } else {}; x`, "null"},
+		{`let x = if (true) { let y = 1; }; x`, "null"},
+		{`[if (true) {}, if (false) { 1 } else { let y = 2; }]`, "[null, null]"},
+	}
+
+	for _, tt := range tests {
+		evaluated := testEval(tt.input)
+
+		if evaluated.Inspect() != tt.expected {
+			t.Errorf("%s: wrong result. got=%q, want=%q", tt.input, evaluated.Inspect(), tt.expected)
+		}
+	}
+}
@@ -29,10 +29,9 @@ func (l *Lexer) NextToken() token.Token {
 	switch l.ch {
 	case '=':
 		if l.peekChar() == '=' {
-			ch := l.ch
-			l.readChar()
-			tok.Type = token.EQ
-			tok.Literal = string(ch) + string(l.ch)
+			tok = l.readTwoCharToken(token.EQ)
+		} else if l.peekChar() == '>' {
+			tok = l.readTwoCharToken(token.ARROW)
 		} else {
 			tok = newToken(token.ASSIGN, '=')
 		}
@@ -41,26 +40,59 @@ func (l *Lexer) NextToken() token.Token {
 	case '<':
 		tok = newToken(token.LT, '<')
 	case '*':
-		tok = newToken(token.ASTERISK, '*')
+		if l.peekChar() == '=' {
+			tok = l.readTwoCharToken(token.ASTERISK_ASSIGN)
+		} else {
+			tok = newToken(token.ASTERISK, '*')
+		}
 	case '/':
-		tok = newToken(token.SLASH, '/')
+		if l.peekChar() == '=' {
+			tok = l.readTwoCharToken(token.SLASH_ASSIGN)
+		} else {
+			tok = newToken(token.SLASH, '/')
+		}
+	case '%':
+		if l.peekChar() == '=' {
+			tok = l.readTwoCharToken(token.PERCENT_ASSIGN)
+		} else {
+			tok = newToken(token.PERCENT, '%')
+		}
 	case '!':
 		if l.peekChar() == '=' {
-			ch := l.ch
-			l.readChar()
-			tok.Type = token.NOT_EQ
-			tok.Literal = string(ch) + string(l.ch)
+			tok = l.readTwoCharToken(token.NOT_EQ)
 		} else {
 			tok = newToken(token.BANG, '!')
 		}
+	case '|':
+		if l.peekChar() == '>' {
+			tok = l.readTwoCharToken(token.PIPE)
+		} else {
+			tok = newToken(token.BAR, '|')
+		}
+	case '?':
+		if l.peekChar() == '?' {
+			tok = l.readTwoCharToken(token.NULLISH)
+		} else if l.peekChar() == '.' {
+			tok = l.readTwoCharToken(token.OPTIONAL)
+		} else {
+			tok = newToken(token.QUESTION, '?')
+		}
 	case ';':
 		tok = newToken(token.SEMICOLON, ';')
 	case ':':
 		tok = newToken(token.COLON, ':')
 	case '+':
-		tok = newToken(token.PLUS, '+')
+		if l.peekChar() == '=' {
+			tok = l.readTwoCharToken(token.PLUS_ASSIGN)
+		} else {
+			tok = newToken(token.PLUS, '+')
+		}
 	case '-':
-		tok = newToken(token.MINUS, '-')
+		if l.peekChar() == '=' {
+			tok = l.readTwoCharToken(token.MINUS_ASSIGN)
+		} else {
+			tok = newToken(token.MINUS, '-')
+		}
 	case '(':
 		tok = newToken(token.LPAREN, '(')
 	case ')':
@@ -75,6 +107,20 @@ func (l *Lexer) NextToken() token.Token {
 		tok = newToken(token.RBRACKET, ']')
 	case ',':
 		tok = newToken(token.COMMA, ',')
+	case '.':
+		if l.peekChar() == '.' && l.peekNextChar() == '.' {
+			l.readChar()
+			l.readChar()
+			tok = token.Token{Type: token.ELLIPSIS, Literal: "..."}
+		} else if l.peekChar() == '.' && l.peekNextChar() == '=' {
+			l.readChar()
+			l.readChar()
+			tok = token.Token{Type: token.RANGE_EQ, Literal: "..="}
+		} else if l.peekChar() == '.' {
+			tok = l.readTwoCharToken(token.RANGE)
+		} else {
+			tok = newToken(token.DOT, '.')
+		}
 	case '"':
 		tok.Type = token.STRING
 		tok.Literal = l.readString()
@@ -88,8 +134,7 @@ func (l *Lexer) NextToken() token.Token {
 			tok.Type = token.LookupIdent(tok.Literal)
 			return tok
 		} else if isDigit(l.ch) {
-			tok.Literal = l.readNumber()
-			tok.Type = token.INT
+			tok.Literal, tok.Type = l.readNumber()
 			return tok
 		} else {
 			// If char is not a specific token and it's not a letter, then it's an illegal character
@@ -109,6 +154,17 @@ func newToken(tokenType token.TokenType, ch byte) token.Token {
 	}
 }
 
+// Consumes the current and the next char as a single token, e.g. "==" or "+="
+func (l *Lexer) readTwoCharToken(tokenType token.TokenType) token.Token {
+	ch := l.ch
+	l.readChar()
+
+	return token.Token{
+		Type:    tokenType,
+		Literal: string(ch) + string(l.ch),
+	}
+}
+
 // Specifies which chars are allowed to be part of an identifier, such as functions or variables
 func isLetter(ch byte) bool {
 	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || ch == '_'
@@ -120,10 +176,11 @@ func (l *Lexer) skipWhitespace() {
 	}
 }
 
+// Identifiers start with a letter and may contain digits after it, e.g. log10
 func (l *Lexer) readIdentifier() string {
 	position := l.position
 
-	for isLetter(l.ch) {
+	for isLetter(l.ch) || isDigit(l.ch) {
 		l.readChar()
 	}
 
@@ -148,15 +205,26 @@ func isDigit(ch byte) bool {
 	return ch >= '0' && ch <= '9'
 }
 
-// Only supports integer numbers. Floats or hex and octal numbers are not supported
-func (l *Lexer) readNumber() string {
+// Supports integer and decimal floating point numbers, e.g. 10 and 10.5. A dot is only part of the
+// number when a digit follows it. Exponents or hex and octal numbers are not supported
+func (l *Lexer) readNumber() (string, token.TokenType) {
 	position := l.position
+	tokenType := token.TokenType(token.INT)
 
 	for isDigit(l.ch) {
 		l.readChar()
 	}
 
-	return l.input[position:l.position]
+	if l.ch == '.' && isDigit(l.peekChar()) {
+		tokenType = token.FLOAT
+		l.readChar()
+
+		for isDigit(l.ch) {
+			l.readChar()
+		}
+	}
+
+	return l.input[position:l.position], tokenType
 }
 
 func (l *Lexer) peekChar() byte {
@@ -167,6 +235,15 @@ func (l *Lexer) peekChar() byte {
 	}
 }
 
+// Looks two chars ahead, past the one returned by peekChar
+func (l *Lexer) peekNextChar() byte {
+	if l.readPosition+1 >= len(l.input) {
+		return 0
+	}
+
+	return l.input[l.readPosition+1]
+}
+
 func New(input string) *Lexer {
 	l := &Lexer{
 		input: input,
@@ -34,6 +34,30 @@ func TestNextToken(t *testing.T) {
 
     [1, 2];
     {"foo": "bar"};
+
+    x = 10 % 3;
+    x += 1;
+    x -= 1;
+    x *= 2;
+    x /= 2;
+    x %= 2;
+    person.name;
+    const max = 10;
+    let [head, ...tail] = list;
+    match (x) { 1 | 2 => true }
+    try { throw x } catch (e) { e } finally { x }
+    import "lib" as lib;
+    export let y = 1;
+    3.14 + 0.5;
+    5.name;
+    log10;
+    0..10;
+    1..=n;
+    a?.b ?? null;
+    xs |> f;
+    ok ? 1 : 2;
+    struct Point { x, y }
+    enum Shape { Empty }
 `
 
 	tests := []struct {
@@ -129,6 +153,135 @@ func TestNextToken(t *testing.T) {
 		{token.STRING, "bar"},
 		{token.RBRACE, "}"},
 		{token.SEMICOLON, ";"},
+		{token.IDENT, "x"},
+		{token.ASSIGN, "="},
+		{token.INT, "10"},
+		{token.PERCENT, "%"},
+		{token.INT, "3"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "x"},
+		{token.PLUS_ASSIGN, "+="},
+		{token.INT, "1"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "x"},
+		{token.MINUS_ASSIGN, "-="},
+		{token.INT, "1"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "x"},
+		{token.ASTERISK_ASSIGN, "*="},
+		{token.INT, "2"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "x"},
+		{token.SLASH_ASSIGN, "/="},
+		{token.INT, "2"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "x"},
+		{token.PERCENT_ASSIGN, "%="},
+		{token.INT, "2"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "person"},
+		{token.DOT, "."},
+		{token.IDENT, "name"},
+		{token.SEMICOLON, ";"},
+		{token.CONST, "const"},
+		{token.IDENT, "max"},
+		{token.ASSIGN, "="},
+		{token.INT, "10"},
+		{token.SEMICOLON, ";"},
+		{token.LET, "let"},
+		{token.LBRACKET, "["},
+		{token.IDENT, "head"},
+		{token.COMMA, ","},
+		{token.ELLIPSIS, "..."},
+		{token.IDENT, "tail"},
+		{token.RBRACKET, "]"},
+		{token.ASSIGN, "="},
+		{token.IDENT, "list"},
+		{token.SEMICOLON, ";"},
+		{token.MATCH, "match"},
+		{token.LPAREN, "("},
+		{token.IDENT, "x"},
+		{token.RPAREN, ")"},
+		{token.LBRACE, "{"},
+		{token.INT, "1"},
+		{token.BAR, "|"},
+		{token.INT, "2"},
+		{token.ARROW, "=>"},
+		{token.TRUE, "true"},
+		{token.RBRACE, "}"},
+		{token.TRY, "try"},
+		{token.LBRACE, "{"},
+		{token.THROW, "throw"},
+		{token.IDENT, "x"},
+		{token.RBRACE, "}"},
+		{token.CATCH, "catch"},
+		{token.LPAREN, "("},
+		{token.IDENT, "e"},
+		{token.RPAREN, ")"},
+		{token.LBRACE, "{"},
+		{token.IDENT, "e"},
+		{token.RBRACE, "}"},
+		{token.FINALLY, "finally"},
+		{token.LBRACE, "{"},
+		{token.IDENT, "x"},
+		{token.RBRACE, "}"},
+		{token.IMPORT, "import"},
+		{token.STRING, "lib"},
+		{token.AS, "as"},
+		{token.IDENT, "lib"},
+		{token.SEMICOLON, ";"},
+		{token.EXPORT, "export"},
+		{token.LET, "let"},
+		{token.IDENT, "y"},
+		{token.ASSIGN, "="},
+		{token.INT, "1"},
+		{token.SEMICOLON, ";"},
+		{token.FLOAT, "3.14"},
+		{token.PLUS, "+"},
+		{token.FLOAT, "0.5"},
+		{token.SEMICOLON, ";"},
+		{token.INT, "5"},
+		{token.DOT, "."},
+		{token.IDENT, "name"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "log10"},
+		{token.SEMICOLON, ";"},
+		{token.INT, "0"},
+		{token.RANGE, ".."},
+		{token.INT, "10"},
+		{token.SEMICOLON, ";"},
+		{token.INT, "1"},
+		{token.RANGE_EQ, "..="},
+		{token.IDENT, "n"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "a"},
+		{token.OPTIONAL, "?."},
+		{token.IDENT, "b"},
+		{token.NULLISH, "??"},
+		{token.NULL, "null"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "xs"},
+		{token.PIPE, "|>"},
+		{token.IDENT, "f"},
+		{token.SEMICOLON, ";"},
+		{token.IDENT, "ok"},
+		{token.QUESTION, "?"},
+		{token.INT, "1"},
+		{token.COLON, ":"},
+		{token.INT, "2"},
+		{token.SEMICOLON, ";"},
+		{token.STRUCT, "struct"},
+		{token.IDENT, "Point"},
+		{token.LBRACE, "{"},
+		{token.IDENT, "x"},
+		{token.COMMA, ","},
+		{token.IDENT, "y"},
+		{token.RBRACE, "}"},
+		{token.ENUM, "enum"},
+		{token.IDENT, "Shape"},
+		{token.LBRACE, "{"},
+		{token.IDENT, "Empty"},
+		{token.RBRACE, "}"},
 		{token.EOF, ""},
 	}
 
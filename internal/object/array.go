@@ -7,12 +7,39 @@ import (
 
 type Array struct {
 	Elements []Object
+	Frozen   bool
+}
+
+// Makes the array and every nested array or hash immutable
+func (a *Array) Freeze() {
+	if a.Frozen {
+		return
+	}
+
+	a.Frozen = true
+
+	for _, element := range a.Elements {
+		if f, ok := element.(Freezable); ok {
+			f.Freeze()
+		}
+	}
 }
 
 func (a *Array) Type() ObjectType {
 	return ARRAY_OBJ
 }
 
+// Combines the hash keys of the elements in order. Only meaningful when AsHashable accepts the array.
+func (a *Array) HashKey() HashKey {
+	keys := make([]HashKey, len(a.Elements))
+
+	for idx, element := range a.Elements {
+		keys[idx] = element.(Hashable).HashKey()
+	}
+
+	return HashKey{Type: ARRAY_OBJ, Value: combineHashKeys(ARRAY_OBJ, keys)}
+}
+
 func (a *Array) Inspect() string {
 	var out bytes.Buffer
 
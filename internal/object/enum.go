@@ -0,0 +1,138 @@
+package object
+
+import (
+	"bytes"
+	"strings"
+)
+
+// A type declared with enum, made of the variants its values are tagged with
+type EnumType struct {
+	Name     string
+	Variants []*EnumVariant
+}
+
+func (et *EnumType) Type() ObjectType {
+	return ENUM_TYPE_OBJ
+}
+
+func (et *EnumType) Inspect() string {
+	if len(et.Variants) == 0 {
+		return "enum " + et.Name + " {}"
+	}
+
+	variants := []string{}
+	for _, variant := range et.Variants {
+		variants = append(variants, variant.declaration())
+	}
+
+	return "enum " + et.Name + " { " + strings.Join(variants, ", ") + " }"
+}
+
+func (et *EnumType) Variant(name string) (*EnumVariant, bool) {
+	for _, variant := range et.Variants {
+		if variant.Name == name {
+			return variant, true
+		}
+	}
+
+	return nil, false
+}
+
+// A variant of an enum. Variants with fields are the constructors of their values, while variants
+// without them, such as Empty, have a single value
+type EnumVariant struct {
+	Enum   *EnumType
+	Name   string
+	Fields []string // nil for variants carrying no values
+}
+
+func (ev *EnumVariant) Type() ObjectType {
+	return ENUM_VARIANT_OBJ
+}
+
+func (ev *EnumVariant) Inspect() string {
+	return ev.Enum.Name + "." + ev.declaration()
+}
+
+func (ev *EnumVariant) IsUnit() bool {
+	return ev.Fields == nil
+}
+
+func (ev *EnumVariant) FieldIndex(name string) (int, bool) {
+	for idx, field := range ev.Fields {
+		if field == name {
+			return idx, true
+		}
+	}
+
+	return 0, false
+}
+
+func (ev *EnumVariant) declaration() string {
+	if ev.IsUnit() {
+		return ev.Name
+	}
+
+	return ev.Name + "(" + strings.Join(ev.Fields, ", ") + ")"
+}
+
+// A value tagged with one of the variants of an enum, holding a value for each of the variant's
+// fields. Enum values can't be changed once built
+type EnumValue struct {
+	Variant *EnumVariant
+	Values  []Object
+}
+
+func (ev *EnumValue) Type() ObjectType {
+	return ENUM_OBJ
+}
+
+func (ev *EnumValue) Get(field string) (Object, bool) {
+	idx, ok := ev.Variant.FieldIndex(field)
+	if !ok {
+		return nil, false
+	}
+
+	return ev.Values[idx], true
+}
+
+// Freezes every nested array, hash or struct, since the value itself is already immutable
+func (ev *EnumValue) Freeze() {
+	for _, value := range ev.Values {
+		if f, ok := value.(Freezable); ok {
+			f.Freeze()
+		}
+	}
+}
+
+// Combines the enum and variant names with the hash keys of the values. Only meaningful when
+// AsHashable accepts the value.
+func (ev *EnumValue) HashKey() HashKey {
+	keys := []HashKey{
+		(&String{Value: ev.Variant.Enum.Name}).HashKey(),
+		(&String{Value: ev.Variant.Name}).HashKey(),
+	}
+
+	for _, value := range ev.Values {
+		keys = append(keys, value.(Hashable).HashKey())
+	}
+
+	return HashKey{Type: ENUM_OBJ, Value: combineHashKeys(ENUM_OBJ, keys)}
+}
+
+func (ev *EnumValue) Inspect() string {
+	var out bytes.Buffer
+
+	out.WriteString(ev.Variant.Enum.Name + "." + ev.Variant.Name)
+
+	if !ev.Variant.IsUnit() {
+		values := []string{}
+		for _, value := range ev.Values {
+			values = append(values, value.Inspect())
+		}
+
+		out.WriteString("(" + strings.Join(values, ", ") + ")")
+	}
+
+	return out.String()
+}
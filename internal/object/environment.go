@@ -1,8 +1,10 @@
 package object
 
 type Environment struct {
-	store map[string]Object
-	outer *Environment
+	store     map[string]Object
+	constants map[string]bool
+	outer     *Environment
+	module    string // Path of the module this is the top level environment of
 }
 
 func (e *Environment) Get(name string) (Object, bool) {
@@ -20,9 +22,69 @@ func (e *Environment) Set(name string, value Object) Object {
 	return value
 }
 
+// Binds name as a constant, which Assign refuses to update
+func (e *Environment) SetConst(name string, value Object) Object {
+	e.constants[name] = true
+	return e.Set(name, value)
+}
+
+// Reports whether the nearest binding for name is a constant
+func (e *Environment) IsConst(name string) bool {
+	if _, ok := e.store[name]; ok {
+		return e.constants[name]
+	}
+
+	if e.outer != nil {
+		return e.outer.IsConst(name)
+	}
+
+	return false
+}
+
+// Looks name up in this environment itself, ignoring the enclosing ones
+func (e *Environment) GetInScope(name string) (Object, bool) {
+	obj, ok := e.store[name]
+	return obj, ok
+}
+
+// Reports whether name is bound as a constant in this environment itself, ignoring the enclosing ones
+func (e *Environment) IsConstInScope(name string) bool {
+	return e.constants[name]
+}
+
+// Updates the nearest existing binding for name, walking up the enclosing environments.
+// Returns false when the name is not bound anywhere or the binding is a constant.
+func (e *Environment) Assign(name string, value Object) (Object, bool) {
+	if _, ok := e.store[name]; ok {
+		if e.constants[name] {
+			return nil, false
+		}
+
+		e.store[name] = value
+		return value, true
+	}
+
+	if e.outer != nil {
+		return e.outer.Assign(name, value)
+	}
+
+	return nil, false
+}
+
+// Returns the path of the module the environment belongs to, or an empty string for code that
+// wasn't loaded from a file, such as the REPL
+func (e *Environment) ModulePath() string {
+	if e.module != "" || e.outer == nil {
+		return e.module
+	}
+
+	return e.outer.ModulePath()
+}
+
 func NewEnvironment() *Environment {
 	s := make(map[string]Object)
-	return &Environment{store: s, outer: nil}
+	c := make(map[string]bool)
+	return &Environment{store: s, constants: c, outer: nil}
 }
 
 func NewEnclosedEnvironment(outer *Environment) *Environment {
@@ -31,3 +93,10 @@ func NewEnclosedEnvironment(outer *Environment) *Environment {
 
 	return env
 }
+
+func NewModuleEnvironment(path string, outer *Environment) *Environment {
+	env := NewEnclosedEnvironment(outer)
+	env.module = path
+
+	return env
+}
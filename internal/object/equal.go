@@ -0,0 +1,136 @@
+package object
+
+// Reports whether a and b are structurally equal: numbers, strings, booleans and nulls by value,
+// arrays, hashes, structs of the same type and enum values of the same variant by their contents,
+// ranges by the integers they produce, and anything else, such as functions, only to itself.
+// Integers and floats are compared numerically, so 2 == 2.0.
+func Equal(a, b Object) bool {
+	return equal(a, b, map[[2]Object]bool{})
+}
+
+// comparing holds the pairs of arrays and hashes being compared further up, so values that contain
+// themselves are considered equal when their cycles line up instead of recursing forever
+func equal(a, b Object, comparing map[[2]Object]bool) bool {
+	if a == b {
+		return true
+	}
+
+	switch a := a.(type) {
+	case *Integer:
+		switch b := b.(type) {
+		case *Integer:
+			return a.Value == b.Value
+		case *Float:
+			return float64(a.Value) == b.Value
+		}
+	case *Float:
+		switch b := b.(type) {
+		case *Integer:
+			return a.Value == float64(b.Value)
+		case *Float:
+			return a.Value == b.Value
+		}
+	case *String:
+		if b, ok := b.(*String); ok {
+			return a.Value == b.Value
+		}
+	case *Boolean:
+		if b, ok := b.(*Boolean); ok {
+			return a.Value == b.Value
+		}
+	case *Null:
+		_, ok := b.(*Null)
+		return ok
+	case *Array:
+		if b, ok := b.(*Array); ok {
+			return equalContainers(a, b, comparing, func() bool {
+				return equalArrays(a, b, comparing)
+			})
+		}
+	case *Hash:
+		if b, ok := b.(*Hash); ok {
+			return equalContainers(a, b, comparing, func() bool {
+				return equalHashes(a, b, comparing)
+			})
+		}
+	case *Range:
+		if b, ok := b.(*Range); ok {
+			return equalRanges(a, b)
+		}
+	case *Struct:
+		if b, ok := b.(*Struct); ok && a.Definition == b.Definition {
+			return equalContainers(a, b, comparing, func() bool {
+				return equalElements(a.Values, b.Values, comparing)
+			})
+		}
+	case *EnumValue:
+		if b, ok := b.(*EnumValue); ok && a.Variant == b.Variant {
+			return equalContainers(a, b, comparing, func() bool {
+				return equalElements(a.Values, b.Values, comparing)
+			})
+		}
+	}
+
+	return false
+}
+
+func equalContainers(a, b Object, comparing map[[2]Object]bool, compare func() bool) bool {
+	key := [2]Object{a, b}
+	if comparing[key] {
+		return true
+	}
+
+	comparing[key] = true
+	defer delete(comparing, key)
+
+	return compare()
+}
+
+func equalArrays(a, b *Array, comparing map[[2]Object]bool) bool {
+	return equalElements(a.Elements, b.Elements, comparing)
+}
+
+func equalElements(a, b []Object, comparing map[[2]Object]bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for idx, element := range a {
+		if !equal(element, b[idx], comparing) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Hashes are equal when they have the same keys with equal values, whatever their insertion order
+func equalHashes(a, b *Hash, comparing map[[2]Object]bool) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+
+	for _, pair := range a.Pairs() {
+		value, ok := b.Get(pair.Key.(Hashable))
+		if !ok || !equal(pair.Value, value, comparing) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Ranges are equal when they produce the same integers, so 0..3 == 0..=2
+func equalRanges(a, b *Range) bool {
+	length := a.Len()
+
+	if length != b.Len() {
+		return false
+	}
+
+	if length == 0 {
+		return true
+	}
+
+	return a.Start == b.Start && (length == 1 || a.Step == b.Step)
+}
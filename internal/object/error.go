@@ -1,7 +1,16 @@
 package object
 
+// Kinds of errors, exposed to user code when an error is caught
+const (
+	RUNTIME_ERROR = "RuntimeError" // Raised by the interpreter itself or by builtins
+	THROWN_ERROR  = "Error"        // Raised by throw, unless the thrown hash sets its own kind
+)
+
 type Error struct {
 	Message string
+	Kind    string
+	Value   Object   // The thrown value, nil for runtime errors
+	Stack   []string // Calls the error propagated through, innermost first
 }
 
 func (e *Error) Type() ObjectType {
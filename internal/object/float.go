@@ -0,0 +1,25 @@
+package object
+
+import (
+	"math"
+	"strconv"
+)
+
+type Float struct {
+	Value float64
+}
+
+func (f *Float) Type() ObjectType {
+	return FLOAT_OBJ
+}
+
+// Whole floats keep a decimal part, e.g. 2.0, so they can be told apart from integers
+func (f *Float) Inspect() string {
+	formatted := strconv.FormatFloat(f.Value, 'f', -1, 64)
+
+	if f.Value == math.Trunc(f.Value) && !math.IsInf(f.Value, 0) {
+		formatted += ".0"
+	}
+
+	return formatted
+}
@@ -8,7 +8,7 @@ import (
 )
 
 type Function struct {
-	Parameters []*ast.Identifier
+	Parameters []ast.Pattern
 	Body       *ast.BlockStatement
 	Env        *Environment
 }
@@ -2,7 +2,9 @@ package object
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
 	"strings"
 )
 
@@ -12,27 +14,234 @@ type HashKey struct {
 }
 
 type Hashable interface {
+	Object
 	HashKey() HashKey
 }
 
+// Returns obj as a hash key when it can be one. Integers, strings and booleans always can, while
+// arrays, hashes and structs can once frozen, as long as everything they contain can too and they
+// don't contain themselves. Enum values can whenever the values they hold can.
+func AsHashable(obj Object) (Hashable, bool) {
+	if !isHashable(obj, map[Object]bool{}) {
+		return nil, false
+	}
+
+	return obj.(Hashable), true
+}
+
+func isHashable(obj Object, visiting map[Object]bool) bool {
+	switch obj := obj.(type) {
+	case *Array:
+		if !obj.Frozen || visiting[obj] {
+			return false
+		}
+
+		visiting[obj] = true
+		defer delete(visiting, obj)
+
+		for _, element := range obj.Elements {
+			if !isHashable(element, visiting) {
+				return false
+			}
+		}
+
+		return true
+	case *Hash:
+		if !obj.Frozen || visiting[obj] {
+			return false
+		}
+
+		visiting[obj] = true
+		defer delete(visiting, obj)
+
+		for _, pair := range obj.pairs {
+			if !isHashable(pair.Value, visiting) {
+				return false
+			}
+		}
+
+		return true
+	case *Struct:
+		if !obj.Frozen || visiting[obj] {
+			return false
+		}
+
+		visiting[obj] = true
+		defer delete(visiting, obj)
+
+		for _, value := range obj.Values {
+			if !isHashable(value, visiting) {
+				return false
+			}
+		}
+
+		return true
+	case *EnumValue:
+		// Enum values are immutable, so they only need their values to be hashable
+		if visiting[obj] {
+			return false
+		}
+
+		visiting[obj] = true
+		defer delete(visiting, obj)
+
+		for _, value := range obj.Values {
+			if !isHashable(value, visiting) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		_, ok := obj.(Hashable)
+		return ok
+	}
+}
+
+// Mixes hash keys into a single value with FNV, so their order matters
+func combineHashKeys(objectType ObjectType, keys []HashKey) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(objectType))
+
+	buf := make([]byte, 8)
+
+	for _, key := range keys {
+		h.Write([]byte(key.Type))
+		binary.LittleEndian.PutUint64(buf, key.Value)
+		h.Write(buf)
+	}
+
+	return h.Sum64()
+}
+
 type HashPair struct {
 	Key   Object
 	Value Object
 }
 
+// Hash keeps its pairs in insertion order, so inspecting or iterating it is deterministic. Keys whose
+// hash keys collide share a bucket and are told apart by structural equality.
 type Hash struct {
-	Pairs map[HashKey]HashPair
+	buckets map[HashKey][]int // Positions in pairs of the keys with each hash key
+	pairs   []HashPair
+	Frozen  bool
+	Proto   *Hash // Consulted by Lookup for the keys the hash lacks. Optional
+}
+
+func NewHash() *Hash {
+	return &Hash{buckets: make(map[HashKey][]int)}
+}
+
+// Makes the hash and every nested array or hash immutable
+func (h *Hash) Freeze() {
+	if h.Frozen {
+		return
+	}
+
+	h.Frozen = true
+
+	for _, pair := range h.pairs {
+		if f, ok := pair.Value.(Freezable); ok {
+			f.Freeze()
+		}
+	}
 }
 
 func (h *Hash) Type() ObjectType {
 	return HASH_OBJ
 }
 
+// Combines the hash keys of every pair regardless of their order, matching structural equality.
+// Only meaningful when AsHashable accepts the hash.
+func (h *Hash) HashKey() HashKey {
+	var sum uint64
+
+	for _, pair := range h.pairs {
+		key := pair.Key.(Hashable).HashKey()
+		value := pair.Value.(Hashable).HashKey()
+
+		sum += combineHashKeys(HASH_OBJ, []HashKey{key, value})
+	}
+
+	return HashKey{Type: HASH_OBJ, Value: sum}
+}
+
+// Inserts the pair, replacing the value already stored under an equal key but keeping its position
+func (h *Hash) Set(key Hashable, value Object) {
+	if idx, ok := h.find(key); ok {
+		h.pairs[idx] = HashPair{Key: key, Value: value}
+		return
+	}
+
+	hashKey := key.HashKey()
+
+	h.buckets[hashKey] = append(h.buckets[hashKey], len(h.pairs))
+	h.pairs = append(h.pairs, HashPair{Key: key, Value: value})
+}
+
+func (h *Hash) Get(key Hashable) (Object, bool) {
+	idx, ok := h.find(key)
+	if !ok {
+		return nil, false
+	}
+
+	return h.pairs[idx].Value, true
+}
+
+// Like Get, but delegates the keys the hash lacks to its prototype chain
+func (h *Hash) Lookup(key Hashable) (Object, bool) {
+	for hash := h; hash != nil; hash = hash.Proto {
+		if value, ok := hash.Get(key); ok {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+// Removes the pair stored under key, reporting whether there was one
+func (h *Hash) Delete(key Hashable) bool {
+	idx, ok := h.find(key)
+	if !ok {
+		return false
+	}
+
+	h.pairs = append(h.pairs[:idx], h.pairs[idx+1:]...)
+
+	// Positions after the removed pair shifted, so the buckets are rebuilt
+	h.buckets = make(map[HashKey][]int, len(h.pairs))
+	for i, pair := range h.pairs {
+		hashKey := pair.Key.(Hashable).HashKey()
+		h.buckets[hashKey] = append(h.buckets[hashKey], i)
+	}
+
+	return true
+}
+
+func (h *Hash) find(key Hashable) (int, bool) {
+	for _, idx := range h.buckets[key.HashKey()] {
+		if Equal(h.pairs[idx].Key, key) {
+			return idx, true
+		}
+	}
+
+	return 0, false
+}
+
+func (h *Hash) Len() int {
+	return len(h.pairs)
+}
+
+// Returns the pairs in insertion order. The slice must not be modified.
+func (h *Hash) Pairs() []HashPair {
+	return h.pairs
+}
+
 func (h *Hash) Inspect() string {
 	var out bytes.Buffer
 
 	pairs := []string{}
-	for _, pair := range h.Pairs {
+	for _, pair := range h.pairs {
 		pairs = append(pairs, fmt.Sprintf("%s: %s", pair.Key.Inspect(), pair.Value.Inspect()))
 	}
 
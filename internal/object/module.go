@@ -0,0 +1,24 @@
+package object
+
+type Module struct {
+	Path    string
+	Env     *Environment
+	Exports map[string]bool
+}
+
+func (m *Module) Type() ObjectType {
+	return MODULE_OBJ
+}
+
+func (m *Module) Inspect() string {
+	return "module(" + m.Path + ")"
+}
+
+// Looks up an exported binding. Exports are live, so later updates inside the module are visible
+func (m *Module) Get(name string) (Object, bool) {
+	if !m.Exports[name] {
+		return nil, false
+	}
+
+	return m.Env.Get(name)
+}
@@ -4,6 +4,7 @@ type ObjectType string
 
 const (
 	INTEGER_OBJ      ObjectType = "INTEGER"
+	FLOAT_OBJ        ObjectType = "FLOAT"
 	STRING_OBJ       ObjectType = "STRING"
 	BOOLEAN_OBJ      ObjectType = "BOOLEAN"
 	NULL_OBJ         ObjectType = "NULL"
@@ -13,9 +14,22 @@ const (
 	BUILTIN_OBJ      ObjectType = "BUILTIN"
 	ARRAY_OBJ        ObjectType = "ARRAY"
 	HASH_OBJ         ObjectType = "HASH"
+	RANGE_OBJ        ObjectType = "RANGE"
+	STRUCT_TYPE_OBJ  ObjectType = "STRUCT_TYPE"
+	STRUCT_OBJ       ObjectType = "STRUCT"
+	ENUM_TYPE_OBJ    ObjectType = "ENUM_TYPE"
+	ENUM_VARIANT_OBJ ObjectType = "ENUM_VARIANT"
+	ENUM_OBJ         ObjectType = "ENUM"
+	MODULE_OBJ       ObjectType = "MODULE"
 )
 
 type Object interface {
 	Type() ObjectType
 	Inspect() string
 }
+
+// Implemented by objects that can be made immutable with the freeze builtin
+type Freezable interface {
+	Object
+	Freeze()
+}
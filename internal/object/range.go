@@ -0,0 +1,138 @@
+package object
+
+import (
+	"fmt"
+	"math"
+)
+
+// A lazy sequence of integers from Start towards End, moving Step at a time. Elements are
+// computed on demand, so even huge ranges cost a few integers until turned into an array.
+type Range struct {
+	Start     int64
+	End       int64
+	Step      int64 // Never zero
+	Inclusive bool  // Whether End itself is part of the range when a step lands on it
+}
+
+func (r *Range) Type() ObjectType {
+	return RANGE_OBJ
+}
+
+func (r *Range) Inspect() string {
+	operator := ".."
+	if r.Inclusive {
+		operator = "..="
+	}
+
+	if r.Step == 1 {
+		return fmt.Sprintf("%d%s%d", r.Start, operator, r.End)
+	}
+
+	return fmt.Sprintf("%d%s%d step %d", r.Start, operator, r.End, r.Step)
+}
+
+// Counts the elements of the range without producing them. Only meaningful when the range doesn't
+// overflow
+func (r *Range) Len() int64 {
+	length, _ := r.length()
+	return int64(length)
+}
+
+// Reports whether the range has more elements than an int64 can count, e.g.
+// -9223372036854775807..9223372036854775807
+func (r *Range) Overflows() bool {
+	length, ok := r.length()
+	return !ok || length > math.MaxInt64
+}
+
+// Distances between the bounds can exceed an int64, so they are measured in uint64, where the
+// differences and the magnitude of the step are exact
+func (r *Range) length() (uint64, bool) {
+	var distance, step uint64
+
+	if r.Step > 0 {
+		if r.End < r.Start {
+			return 0, true
+		}
+
+		distance, step = uint64(r.End)-uint64(r.Start), uint64(r.Step)
+	} else {
+		if r.End > r.Start {
+			return 0, true
+		}
+
+		distance, step = uint64(r.Start)-uint64(r.End), uint64(-r.Step)
+	}
+
+	if r.Inclusive {
+		if distance/step == math.MaxUint64 {
+			return 0, false
+		}
+
+		return distance/step + 1, true
+	}
+
+	if distance == 0 {
+		return 0, true
+	}
+
+	return (distance-1)/step + 1, true
+}
+
+// Returns the element at idx, which must be between 0 and Len()
+func (r *Range) At(idx int64) int64 {
+	return r.Start + idx*r.Step
+}
+
+// Returns the position of n in the range, or -1 when the range never reaches it
+func (r *Range) IndexOf(n int64) int64 {
+	var offset, step uint64
+
+	if r.Step > 0 {
+		if n < r.Start {
+			return -1
+		}
+
+		offset, step = uint64(n)-uint64(r.Start), uint64(r.Step)
+	} else {
+		if n > r.Start {
+			return -1
+		}
+
+		offset, step = uint64(r.Start)-uint64(n), uint64(-r.Step)
+	}
+
+	if offset%step != 0 || offset/step >= uint64(r.Len()) {
+		return -1
+	}
+
+	return int64(offset / step)
+}
+
+// Returns the elements from start up to, but not including, end as a new range. Both positions
+// must be between 0 and Len(), with start no greater than end.
+func (r *Range) Slice(start, end int64) *Range {
+	// Empty slices start where the range does, since the position they name may be past its end
+	if start == end {
+		return &Range{Start: r.Start, End: r.Start, Step: r.Step}
+	}
+
+	// The element after the last one may not fit in an int64, so slices reaching the end keep its
+	// bound instead
+	if end == r.Len() {
+		return &Range{Start: r.At(start), End: r.End, Step: r.Step, Inclusive: r.Inclusive}
+	}
+
+	return &Range{Start: r.At(start), End: r.At(end), Step: r.Step}
+}
+
+// Materializes the range
+func (r *Range) ToArray() *Array {
+	elements := make([]Object, r.Len())
+
+	for idx := range elements {
+		elements[idx] = &Integer{Value: r.At(int64(idx))}
+	}
+
+	return &Array{Elements: elements}
+}
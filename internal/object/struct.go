@@ -0,0 +1,124 @@
+package object
+
+import (
+	"bytes"
+	"strings"
+)
+
+// A type declared with struct, which is also the constructor of its instances
+type StructType struct {
+	Name    string
+	Fields  []string
+	Methods map[string]*Function // Shared by every instance, which they are called on as self
+}
+
+func (st *StructType) Type() ObjectType {
+	return STRUCT_TYPE_OBJ
+}
+
+func (st *StructType) Inspect() string {
+	if len(st.Fields) == 0 {
+		return "struct " + st.Name + " {}"
+	}
+
+	return "struct " + st.Name + " { " + strings.Join(st.Fields, ", ") + " }"
+}
+
+// Returns the position of a field in the values of the type's instances
+func (st *StructType) FieldIndex(name string) (int, bool) {
+	for idx, field := range st.Fields {
+		if field == name {
+			return idx, true
+		}
+	}
+
+	return 0, false
+}
+
+func (st *StructType) Method(name string) (*Function, bool) {
+	method, ok := st.Methods[name]
+	return method, ok
+}
+
+func (st *StructType) SetMethod(name string, method *Function) {
+	if st.Methods == nil {
+		st.Methods = map[string]*Function{}
+	}
+
+	st.Methods[name] = method
+}
+
+// An instance of a struct type, holding a value for each of its fields in declaration order
+type Struct struct {
+	Definition *StructType
+	Values     []Object
+	Frozen     bool
+}
+
+func (s *Struct) Type() ObjectType {
+	return STRUCT_OBJ
+}
+
+func (s *Struct) Get(field string) (Object, bool) {
+	idx, ok := s.Definition.FieldIndex(field)
+	if !ok {
+		return nil, false
+	}
+
+	return s.Values[idx], true
+}
+
+// Replaces the value of a field, reporting false when the type has no such field
+func (s *Struct) Set(field string, value Object) bool {
+	idx, ok := s.Definition.FieldIndex(field)
+	if !ok {
+		return false
+	}
+
+	s.Values[idx] = value
+
+	return true
+}
+
+// Makes the struct and every nested array, hash or struct immutable
+func (s *Struct) Freeze() {
+	if s.Frozen {
+		return
+	}
+
+	s.Frozen = true
+
+	for _, value := range s.Values {
+		if f, ok := value.(Freezable); ok {
+			f.Freeze()
+		}
+	}
+}
+
+// Combines the type name with the hash keys of the values. Only meaningful when AsHashable accepts
+// the struct.
+func (s *Struct) HashKey() HashKey {
+	keys := []HashKey{(&String{Value: s.Definition.Name}).HashKey()}
+
+	for _, value := range s.Values {
+		keys = append(keys, value.(Hashable).HashKey())
+	}
+
+	return HashKey{Type: STRUCT_OBJ, Value: combineHashKeys(STRUCT_OBJ, keys)}
+}
+
+func (s *Struct) Inspect() string {
+	var out bytes.Buffer
+
+	fields := []string{}
+	for idx, field := range s.Definition.Fields {
+		fields = append(fields, field+": "+s.Values[idx].Inspect())
+	}
+
+	out.WriteString(s.Definition.Name)
+	out.WriteString("{")
+	out.WriteString(strings.Join(fields, ", "))
+	out.WriteString("}")
+
+	return out.String()
+}
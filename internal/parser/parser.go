@@ -12,8 +12,13 @@ import (
 const (
 	_ int = iota
 	LOWEST
+	ASSIGN      // = or +=
+	TERNARY     // a ? b : c
+	NULLISH     // a ?? b
 	EQUALS      // ==
 	LESSGREATER // < or >
+	PIPE        // xs |> f()
+	RANGE       // 0..10
 	SUM         // +
 	PRODUCT     // *
 	PREFIX      // -x or !x
@@ -26,12 +31,27 @@ var precedences = map[token.TokenType]int{
 	token.NOT_EQ:   EQUALS,
 	token.LT:       LESSGREATER,
 	token.GT:       LESSGREATER,
+	token.PIPE:     PIPE,
+	token.RANGE:    RANGE,
+	token.RANGE_EQ: RANGE,
 	token.PLUS:     SUM,
 	token.MINUS:    SUM,
 	token.SLASH:    PRODUCT,
 	token.ASTERISK: PRODUCT,
+	token.PERCENT:  PRODUCT,
 	token.LPAREN:   CALL,
 	token.LBRACKET: INDEX,
+	token.DOT:      INDEX,
+	token.OPTIONAL: INDEX,
+	token.NULLISH:  NULLISH,
+	token.QUESTION: TERNARY,
+
+	token.ASSIGN:          ASSIGN,
+	token.PLUS_ASSIGN:     ASSIGN,
+	token.MINUS_ASSIGN:    ASSIGN,
+	token.ASTERISK_ASSIGN: ASSIGN,
+	token.SLASH_ASSIGN:    ASSIGN,
+	token.PERCENT_ASSIGN:  ASSIGN,
 }
 
 type (
@@ -48,6 +68,9 @@ type Parser struct {
 
 	prefixParseFns map[token.TokenType]prefixParseFn
 	infixParseFns  map[token.TokenType]infixParseFn
+
+	// Set while parsing a match guard, where => ends the guard instead of starting an arrow function
+	noArrowFunctions bool
 }
 
 func (p *Parser) Errors() []string {
@@ -84,10 +107,18 @@ func (p *Parser) ParseProgram() *ast.Program {
 
 func (p *Parser) parseStatement() ast.Statement {
 	switch p.curToken.Type {
-	case token.LET:
+	case token.LET, token.CONST:
 		return p.parseLetStatement()
 	case token.RETURN:
 		return p.parseReturnStatement()
+	case token.IMPORT:
+		return p.parseImportStatement()
+	case token.EXPORT:
+		return p.parseExportStatement()
+	case token.STRUCT:
+		return p.parseStructStatement()
+	case token.ENUM:
+		return p.parseEnumStatement()
 	default:
 		return p.parseExpressionStatement()
 	}
@@ -98,6 +129,124 @@ func (p *Parser) parseLetStatement() *ast.LetStatement {
 		Token: p.curToken,
 	}
 
+	p.nextToken()
+
+	stmt.Name = p.parsePattern()
+	if stmt.Name == nil {
+		return nil
+	}
+
+	if !p.expectPeek(token.ASSIGN) {
+		return nil
+	}
+
+	p.nextToken()
+	stmt.Value = p.parseExpression(LOWEST)
+
+	if p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+
+	return stmt
+}
+
+func (p *Parser) parseReturnStatement() *ast.ReturnStatement {
+	stmt := &ast.ReturnStatement{
+		Token: p.curToken,
+	}
+
+	p.nextToken()
+	stmt.ReturnValue = p.parseExpression(LOWEST)
+
+	if p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+
+	return stmt
+}
+
+func (p *Parser) parseImportStatement() ast.Statement {
+	stmt := &ast.ImportStatement{
+		Token: p.curToken,
+	}
+
+	if !p.expectPeek(token.STRING) {
+		return nil
+	}
+
+	stmt.Path = &ast.StringLiteral{
+		Token: p.curToken,
+		Value: p.curToken.Literal,
+	}
+
+	if !p.expectPeek(token.AS) {
+		return nil
+	}
+
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+
+	stmt.Alias = &ast.Identifier{
+		Token: p.curToken,
+		Value: p.curToken.Literal,
+	}
+
+	if p.peekTokenIs(token.SEMICOLON) {
+		p.nextToken()
+	}
+
+	return stmt
+}
+
+func (p *Parser) parseExportStatement() ast.Statement {
+	stmt := &ast.ExportStatement{
+		Token: p.curToken,
+	}
+
+	switch p.peekToken.Type {
+	case token.LET, token.CONST:
+	case token.STRUCT, token.ENUM:
+		p.nextToken()
+
+		var declaration ast.Statement
+		if p.curTokenIs(token.STRUCT) {
+			declaration = p.parseStructStatement()
+		} else {
+			declaration = p.parseEnumStatement()
+		}
+
+		if declaration == nil {
+			return nil
+		}
+
+		stmt.Statement = declaration
+
+		return stmt
+	default:
+		msg := fmt.Sprintf("expected declaration after export, got %s", p.peekToken.Type)
+		p.errors = append(p.errors, msg)
+		return nil
+	}
+
+	p.nextToken()
+
+	declaration := p.parseLetStatement()
+	if declaration == nil {
+		return nil
+	}
+
+	stmt.Statement = declaration
+
+	return stmt
+}
+
+// Parses struct Point { x, y }, allowing a trailing comma after the last field
+func (p *Parser) parseStructStatement() ast.Statement {
+	stmt := &ast.StructStatement{
+		Token: p.curToken,
+	}
+
 	if !p.expectPeek(token.IDENT) {
 		return nil
 	}
@@ -107,12 +256,36 @@ func (p *Parser) parseLetStatement() *ast.LetStatement {
 		Value: p.curToken.Literal,
 	}
 
-	if !p.expectPeek(token.ASSIGN) {
+	if !p.expectPeek(token.LBRACE) {
 		return nil
 	}
 
+	stmt.Fields = []*ast.Identifier{}
+	declared := map[string]bool{}
+
+	for !p.peekTokenIs(token.RBRACE) {
+		if !p.expectPeek(token.IDENT) {
+			return nil
+		}
+
+		if declared[p.curToken.Literal] {
+			msg := fmt.Sprintf("duplicate field %s in struct %s", p.curToken.Literal, stmt.Name.Value)
+			p.errors = append(p.errors, msg)
+			return nil
+		}
+
+		declared[p.curToken.Literal] = true
+		stmt.Fields = append(stmt.Fields, &ast.Identifier{
+			Token: p.curToken,
+			Value: p.curToken.Literal,
+		})
+
+		if !p.peekTokenIs(token.RBRACE) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+
 	p.nextToken()
-	stmt.Value = p.parseExpression(LOWEST)
 
 	if p.peekTokenIs(token.SEMICOLON) {
 		p.nextToken()
@@ -121,13 +294,55 @@ func (p *Parser) parseLetStatement() *ast.LetStatement {
 	return stmt
 }
 
-func (p *Parser) parseReturnStatement() *ast.ReturnStatement {
-	stmt := &ast.ReturnStatement{
+// Parses enum Shape { Circle(r), Rect(w, h), Empty }, where variants without parentheses carry no
+// values
+func (p *Parser) parseEnumStatement() ast.Statement {
+	stmt := &ast.EnumStatement{
 		Token: p.curToken,
 	}
 
+	if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+
+	stmt.Name = &ast.Identifier{
+		Token: p.curToken,
+		Value: p.curToken.Literal,
+	}
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	stmt.Variants = []*ast.EnumVariant{}
+	declared := map[string]bool{}
+
+	for !p.peekTokenIs(token.RBRACE) {
+		if !p.expectPeek(token.IDENT) {
+			return nil
+		}
+
+		if declared[p.curToken.Literal] {
+			msg := fmt.Sprintf("duplicate variant %s in enum %s", p.curToken.Literal, stmt.Name.Value)
+			p.errors = append(p.errors, msg)
+			return nil
+		}
+
+		declared[p.curToken.Literal] = true
+
+		variant := p.parseEnumVariant()
+		if variant == nil {
+			return nil
+		}
+
+		stmt.Variants = append(stmt.Variants, variant)
+
+		if !p.peekTokenIs(token.RBRACE) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+
 	p.nextToken()
-	stmt.ReturnValue = p.parseExpression(LOWEST)
 
 	if p.peekTokenIs(token.SEMICOLON) {
 		p.nextToken()
@@ -136,6 +351,50 @@ func (p *Parser) parseReturnStatement() *ast.ReturnStatement {
 	return stmt
 }
 
+func (p *Parser) parseEnumVariant() *ast.EnumVariant {
+	variant := &ast.EnumVariant{
+		Name: &ast.Identifier{
+			Token: p.curToken,
+			Value: p.curToken.Literal,
+		},
+	}
+
+	if !p.peekTokenIs(token.LPAREN) {
+		return variant
+	}
+
+	p.nextToken()
+
+	variant.Fields = []*ast.Identifier{}
+	declared := map[string]bool{}
+
+	for !p.peekTokenIs(token.RPAREN) {
+		if !p.expectPeek(token.IDENT) {
+			return nil
+		}
+
+		if declared[p.curToken.Literal] {
+			msg := fmt.Sprintf("duplicate field %s in variant %s", p.curToken.Literal, variant.Name.Value)
+			p.errors = append(p.errors, msg)
+			return nil
+		}
+
+		declared[p.curToken.Literal] = true
+		variant.Fields = append(variant.Fields, &ast.Identifier{
+			Token: p.curToken,
+			Value: p.curToken.Literal,
+		})
+
+		if !p.peekTokenIs(token.RPAREN) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+
+	p.nextToken()
+
+	return variant
+}
+
 func (p *Parser) parseExpressionStatement() *ast.ExpressionStatement {
 	stmt := &ast.ExpressionStatement{
 		Token: p.curToken,
@@ -202,10 +461,17 @@ func (p *Parser) registerInfix(tokenType token.TokenType, fn infixParseFn) {
 }
 
 func (p *Parser) parseIdentifier() ast.Expression {
-	return &ast.Identifier{
+	ident := &ast.Identifier{
 		Token: p.curToken,
 		Value: p.curToken.Literal,
 	}
+
+	if p.peekTokenIs(token.ARROW) && !p.noArrowFunctions {
+		p.nextToken()
+		return p.parseArrowFunctionBody([]ast.Pattern{ident})
+	}
+
+	return ident
 }
 
 func (p *Parser) parseIntegerLiteral() ast.Expression {
@@ -225,6 +491,23 @@ func (p *Parser) parseIntegerLiteral() ast.Expression {
 	return lit
 }
 
+func (p *Parser) parseFloatLiteral() ast.Expression {
+	lit := &ast.FloatLiteral{
+		Token: p.curToken,
+	}
+
+	value, err := strconv.ParseFloat(p.curToken.Literal, 64)
+
+	if err != nil {
+		msg := fmt.Sprintf("could not parse %q as float", p.curToken.Literal)
+		p.errors = append(p.errors, msg)
+		return nil
+	}
+
+	lit.Value = value
+	return lit
+}
+
 func (p *Parser) parseStringLiteral() ast.Expression {
 	lit := &ast.StringLiteral{
 		Token: p.curToken,
@@ -276,6 +559,10 @@ func (p *Parser) parseBoolean() ast.Expression {
 	}
 }
 
+func (p *Parser) parseNullLiteral() ast.Expression {
+	return &ast.NullLiteral{Token: p.curToken}
+}
+
 func (p *Parser) curPrecedence() int {
 	if p, ok := precedences[p.curToken.Type]; ok {
 		return p
@@ -300,125 +587,735 @@ func (p *Parser) parseInfixExpression(left ast.Expression) ast.Expression {
 	return expression
 }
 
-func (p *Parser) parseGroupedExpression() ast.Expression {
+func (p *Parser) parseTernaryExpression(condition ast.Expression) ast.Expression {
+	expression := &ast.TernaryExpression{
+		Token:     p.curToken,
+		Condition: condition,
+	}
+
 	p.nextToken()
-	expression := p.parseExpression(LOWEST)
+	expression.Consequence = p.parseExpression(LOWEST)
 
-	if !p.expectPeek(token.RPAREN) {
+	if !p.expectPeek(token.COLON) {
 		return nil
 	}
 
+	p.nextToken()
+
+	// Right associative, so "a ? b : c ? d : e" is parsed as "a ? b : (c ? d : e)"
+	expression.Alternative = p.parseExpression(TERNARY - 1)
+
 	return expression
 }
 
-func (p *Parser) parseIfExpression() ast.Expression {
-	expression := &ast.IfExpression{
+func (p *Parser) parsePipeExpression(left ast.Expression) ast.Expression {
+	expression := &ast.PipeExpression{
 		Token: p.curToken,
-	}
-
-	if !p.expectPeek(token.LPAREN) {
-		return nil
+		Left:  left,
 	}
 
 	p.nextToken()
-	expression.Condition = p.parseExpression(LOWEST)
-
-	if !p.expectPeek(token.RPAREN) {
-		return nil
-	}
-
-	if !p.expectPeek(token.LBRACE) {
-		return nil
-	}
-
-	expression.Consequence = p.parseBlockStatement()
-
-	if p.peekTokenIs(token.ELSE) {
-		p.nextToken()
-
-		if !p.expectPeek(token.LBRACE) {
-			return nil
-		}
 
-		expression.Alternative = p.parseBlockStatement()
-	}
+	expression.Right = p.parseExpression(PIPE)
 
 	return expression
 }
 
-func (p *Parser) parseBlockStatement() *ast.BlockStatement {
-	block := &ast.BlockStatement{
-		Token: p.curToken,
+// Parses a..b and a..=b, optionally followed by a step, e.g. 10..0 step -2. step is only a keyword
+// right after a range, so it can still be used as an identifier everywhere else
+func (p *Parser) parseRangeExpression(start ast.Expression) ast.Expression {
+	expression := &ast.RangeExpression{
+		Token:     p.curToken,
+		Start:     start,
+		Inclusive: p.curTokenIs(token.RANGE_EQ),
 	}
-	block.Statements = []ast.Statement{}
 
 	p.nextToken()
 
-	for !p.curTokenIs(token.RBRACE) && !p.curTokenIs(token.EOF) {
-		stmt := p.parseStatement()
-
-		if stmt != nil {
-			block.Statements = append(block.Statements, stmt)
-		}
+	expression.End = p.parseExpression(RANGE)
 
+	if p.peekTokenIs(token.IDENT) && p.peekToken.Literal == "step" {
+		p.nextToken()
 		p.nextToken()
+
+		expression.Step = p.parseExpression(RANGE)
 	}
 
-	return block
+	return expression
 }
 
-func (p *Parser) parseFunctionLiteral() ast.Expression {
-	lit := &ast.FunctionLiteral{
-		Token: p.curToken,
+func (p *Parser) parseAssignExpression(target ast.Expression) ast.Expression {
+	expression := &ast.AssignExpression{
+		Token:    p.curToken,
+		Operator: p.curToken.Literal,
+		Target:   target,
 	}
 
-	if !p.expectPeek(token.LPAREN) {
+	// The target failed to parse and the error was already reported
+	if target == nil {
 		return nil
 	}
 
-	lit.Parameters = p.parseFunctionParameters()
-
-	if !p.expectPeek(token.LBRACE) {
+	if !isAssignable(target) {
+		msg := fmt.Sprintf("invalid assignment target: %s", target.String())
+		p.errors = append(p.errors, msg)
 		return nil
 	}
 
-	lit.Body = p.parseBlockStatement()
+	p.nextToken()
 
-	return lit
-}
+	// Assignments are right associative, so "a = b = c" is parsed as "a = (b = c)"
+	expression.Value = p.parseExpression(ASSIGN - 1)
 
-func (p *Parser) parseFunctionParameters() []*ast.Identifier {
-	identifiers := []*ast.Identifier{}
+	return expression
+}
 
-	if p.peekTokenIs(token.RPAREN) {
+// Optional chains can't be assigned to, since there may be nothing to assign into
+func isAssignable(target ast.Expression) bool {
+	switch target := target.(type) {
+	case *ast.Identifier:
+		return true
+	case *ast.IndexExpression:
+		return !target.Optional
+	case *ast.MemberExpression:
+		return !target.Optional
+	default:
+		return false
+	}
+}
+
+func (p *Parser) parseGroupedExpression() ast.Expression {
+	if p.isArrowFunction() {
+		return p.parseArrowFunction()
+	}
+
+	p.nextToken()
+	expression := p.parseExpression(LOWEST)
+
+	if !p.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	return expression
+}
+
+func (p *Parser) parseIfExpression() ast.Expression {
+	expression := &ast.IfExpression{
+		Token: p.curToken,
+	}
+
+	if !p.expectPeek(token.LPAREN) {
+		return nil
+	}
+
+	p.nextToken()
+	expression.Condition = p.parseExpression(LOWEST)
+
+	if !p.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	expression.Consequence = p.parseBlockStatement()
+
+	if !p.peekTokenIs(token.ELSE) {
+		return expression
+	}
+
+	p.nextToken()
+
+	// An else if chain is kept as an alternative block holding the next if expression
+	if p.peekTokenIs(token.IF) {
 		p.nextToken()
-		return identifiers
+
+		block := &ast.BlockStatement{Token: p.curToken}
+
+		stmt := &ast.ExpressionStatement{Token: p.curToken}
+		stmt.Expression = p.parseIfExpression()
+		if stmt.Expression == nil {
+			return nil
+		}
+
+		block.Statements = []ast.Statement{stmt}
+		expression.Alternative = block
+
+		return expression
+	}
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	expression.Alternative = p.parseBlockStatement()
+
+	return expression
+}
+
+func (p *Parser) parseMatchExpression() ast.Expression {
+	expression := &ast.MatchExpression{
+		Token: p.curToken,
+	}
+
+	if !p.expectPeek(token.LPAREN) {
+		return nil
 	}
 
 	p.nextToken()
+	expression.Subject = p.parseExpression(LOWEST)
 
-	ident := &ast.Identifier{
+	if !p.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	for !p.peekTokenIs(token.RBRACE) {
+		p.nextToken()
+
+		arm := p.parseMatchArm()
+		if arm == nil {
+			return nil
+		}
+
+		expression.Arms = append(expression.Arms, arm)
+
+		if !p.peekTokenIs(token.RBRACE) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+
+	if !p.expectPeek(token.RBRACE) {
+		return nil
+	}
+
+	return expression
+}
+
+// Parses "pattern [if guard] => body", where body is either an expression or a block
+func (p *Parser) parseMatchArm() *ast.MatchArm {
+	arm := &ast.MatchArm{}
+
+	arm.Pattern = p.parsePattern()
+	if arm.Pattern == nil {
+		return nil
+	}
+
+	if p.peekTokenIs(token.IF) {
+		p.nextToken()
+		p.nextToken()
+
+		p.noArrowFunctions = true
+		arm.Guard = p.parseExpression(LOWEST)
+		p.noArrowFunctions = false
+
+		if arm.Guard == nil {
+			return nil
+		}
+	}
+
+	if !p.expectPeek(token.ARROW) {
+		return nil
+	}
+
+	p.nextToken()
+
+	if p.curTokenIs(token.LBRACE) {
+		arm.Body = p.parseBlockStatement()
+	} else {
+		arm.Body = p.parseExpression(LOWEST)
+	}
+
+	if arm.Body == nil {
+		return nil
+	}
+
+	return arm
+}
+
+func (p *Parser) parseThrowExpression() ast.Expression {
+	expression := &ast.ThrowExpression{
 		Token: p.curToken,
-		Value: p.curToken.Literal,
 	}
-	identifiers = append(identifiers, ident)
+
+	p.nextToken()
+
+	expression.Value = p.parseExpression(LOWEST)
+	if expression.Value == nil {
+		return nil
+	}
+
+	return expression
+}
+
+func (p *Parser) parseTryExpression() ast.Expression {
+	expression := &ast.TryExpression{
+		Token: p.curToken,
+	}
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	expression.Block = p.parseBlockStatement()
+
+	if p.peekTokenIs(token.CATCH) {
+		p.nextToken()
+
+		if p.peekTokenIs(token.LPAREN) {
+			p.nextToken()
+			p.nextToken()
+
+			expression.CatchParam = p.parsePattern()
+			if expression.CatchParam == nil {
+				return nil
+			}
+
+			if !p.expectPeek(token.RPAREN) {
+				return nil
+			}
+		}
+
+		if !p.expectPeek(token.LBRACE) {
+			return nil
+		}
+
+		expression.Catch = p.parseBlockStatement()
+	}
+
+	if p.peekTokenIs(token.FINALLY) {
+		p.nextToken()
+
+		if !p.expectPeek(token.LBRACE) {
+			return nil
+		}
+
+		expression.Finally = p.parseBlockStatement()
+	}
+
+	if expression.Catch == nil && expression.Finally == nil {
+		msg := fmt.Sprintf("expected catch or finally after try block, got %s", p.peekToken.Type)
+		p.errors = append(p.errors, msg)
+		return nil
+	}
+
+	return expression
+}
+
+func (p *Parser) parseBlockStatement() *ast.BlockStatement {
+	block := &ast.BlockStatement{
+		Token: p.curToken,
+	}
+	block.Statements = []ast.Statement{}
+
+	p.nextToken()
+
+	for !p.curTokenIs(token.RBRACE) && !p.curTokenIs(token.EOF) {
+		stmt := p.parseStatement()
+
+		if stmt != nil {
+			block.Statements = append(block.Statements, stmt)
+		}
+
+		p.nextToken()
+	}
+
+	return block
+}
+
+func (p *Parser) parseFunctionLiteral() ast.Expression {
+	lit := &ast.FunctionLiteral{
+		Token: p.curToken,
+	}
+
+	if !p.expectPeek(token.LPAREN) {
+		return nil
+	}
+
+	lit.Parameters = p.parseFunctionParameters()
+
+	if !p.expectPeek(token.LBRACE) {
+		return nil
+	}
+
+	lit.Body = p.parseBlockStatement()
+
+	return lit
+}
+
+// Reports whether the ( at the current token opens the parameters of an arrow function, by looking
+// past its matching ) for a =>. The lookahead runs on a copy of the lexer, so no token is consumed,
+// and gives up at the first token that can't be part of a parameter list, so grouped expressions
+// are rarely scanned past their first operator.
+func (p *Parser) isArrowFunction() bool {
+	if p.noArrowFunctions {
+		return false
+	}
+
+	scanner := *p.l
+	depth := 1
+	previous := p.curToken
+
+	for tok := p.peekToken; ; previous, tok = tok, scanner.NextToken() {
+		switch tok.Type {
+		case token.LPAREN:
+			// Only constructor patterns, such as Circle(r), open parentheses in parameters
+			if previous.Type != token.IDENT {
+				return false
+			}
+
+			depth++
+		case token.LBRACKET, token.LBRACE:
+			depth++
+		case token.RPAREN, token.RBRACKET, token.RBRACE:
+			depth--
+
+			if depth == 0 {
+				return scanner.NextToken().Type == token.ARROW
+			}
+		case token.IDENT, token.COMMA, token.COLON, token.ELLIPSIS, token.DOT, token.BAR,
+			token.INT, token.FLOAT, token.STRING, token.TRUE, token.FALSE, token.NULL, token.MINUS:
+		default:
+			return false
+		}
+	}
+}
+
+// Parses (a, b) => body, which is sugar for fn(a, b) { body }
+func (p *Parser) parseArrowFunction() ast.Expression {
+	params := p.parseFunctionParameters()
+	if params == nil {
+		return nil
+	}
+
+	if !p.expectPeek(token.ARROW) {
+		return nil
+	}
+
+	return p.parseArrowFunctionBody(params)
+}
+
+// Parses what follows the => of an arrow function, either a block or a single expression
+func (p *Parser) parseArrowFunctionBody(params []ast.Pattern) ast.Expression {
+	lit := &ast.FunctionLiteral{
+		Token:      token.Token{Type: token.FUNCTION, Literal: "fn"},
+		Parameters: params,
+	}
+
+	p.nextToken()
+
+	if p.curTokenIs(token.LBRACE) {
+		lit.Body = p.parseBlockStatement()
+		return lit
+	}
+
+	stmt := &ast.ExpressionStatement{Token: p.curToken}
+
+	stmt.Expression = p.parseExpression(LOWEST)
+	if stmt.Expression == nil {
+		return nil
+	}
+
+	lit.Body = &ast.BlockStatement{
+		Token:      stmt.Token,
+		Statements: []ast.Statement{stmt},
+	}
+
+	return lit
+}
+
+func (p *Parser) parseFunctionParameters() []ast.Pattern {
+	params := []ast.Pattern{}
+
+	if p.peekTokenIs(token.RPAREN) {
+		p.nextToken()
+		return params
+	}
+
+	p.nextToken()
+
+	param := p.parsePattern()
+	if param == nil {
+		return nil
+	}
+	params = append(params, param)
 
 	for p.peekTokenIs(token.COMMA) {
 		p.nextToken()
 		p.nextToken()
 
-		ident := &ast.Identifier{
+		param := p.parsePattern()
+		if param == nil {
+			return nil
+		}
+		params = append(params, param)
+	}
+
+	if !p.expectPeek(token.RPAREN) {
+		return nil
+	}
+
+	return params
+}
+
+// Parses the pattern starting at the current token, e.g. x, [a, ...rest], {name, age: years} or 1 | 2
+func (p *Parser) parsePattern() ast.Pattern {
+	first := p.curToken
+
+	pattern := p.parseSinglePattern()
+	if pattern == nil || !p.peekTokenIs(token.BAR) {
+		return pattern
+	}
+
+	alternatives := &ast.AlternativePattern{
+		Token:        first,
+		Alternatives: []ast.Pattern{pattern},
+	}
+
+	for p.peekTokenIs(token.BAR) {
+		p.nextToken()
+		p.nextToken()
+
+		pattern := p.parseSinglePattern()
+		if pattern == nil {
+			return nil
+		}
+
+		alternatives.Alternatives = append(alternatives.Alternatives, pattern)
+	}
+
+	return alternatives
+}
+
+func (p *Parser) parseSinglePattern() ast.Pattern {
+	switch p.curToken.Type {
+	case token.IDENT:
+		if p.peekTokenIs(token.LPAREN) || p.peekTokenIs(token.DOT) {
+			return p.parseConstructorPattern()
+		}
+
+		return &ast.Identifier{
 			Token: p.curToken,
 			Value: p.curToken.Literal,
 		}
-		identifiers = append(identifiers, ident)
+	case token.LBRACKET:
+		return p.parseArrayPattern()
+	case token.LBRACE:
+		return p.parseHashPattern()
+	case token.INT, token.FLOAT, token.STRING, token.TRUE, token.FALSE, token.NULL, token.MINUS:
+		return p.parseLiteralPattern()
+	default:
+		msg := fmt.Sprintf("expected pattern, got %s", p.curToken.Type)
+		p.errors = append(p.errors, msg)
+		return nil
 	}
+}
 
-	if !p.expectPeek(token.RPAREN) {
+func (p *Parser) parseLiteralPattern() ast.Pattern {
+	pattern := &ast.LiteralPattern{
+		Token: p.curToken,
+	}
+
+	switch p.curToken.Type {
+	case token.STRING:
+		pattern.Value = p.parseStringLiteral()
+	case token.TRUE, token.FALSE:
+		pattern.Value = p.parseBoolean()
+	case token.NULL:
+		pattern.Value = p.parseNullLiteral()
+	case token.FLOAT:
+		pattern.Value = p.parseFloatLiteral()
+	case token.MINUS:
+		p.nextToken()
+
+		var value ast.Expression
+
+		switch p.curToken.Type {
+		case token.INT:
+			value = p.parseIntegerLiteral()
+		case token.FLOAT:
+			value = p.parseFloatLiteral()
+		default:
+			msg := fmt.Sprintf("expected number after - in pattern, got %s", p.curToken.Type)
+			p.errors = append(p.errors, msg)
+		}
+
+		if value == nil {
+			return nil
+		}
+
+		pattern.Value = &ast.PrefixExpression{
+			Token:    pattern.Token,
+			Operator: "-",
+			Right:    value,
+		}
+	default:
+		pattern.Value = p.parseIntegerLiteral()
+	}
+
+	if pattern.Value == nil {
+		return nil
+	}
+
+	return pattern
+}
+
+func (p *Parser) parseArrayPattern() ast.Pattern {
+	pattern := &ast.ArrayPattern{
+		Token: p.curToken,
+	}
+
+	for !p.peekTokenIs(token.RBRACKET) {
+		p.nextToken()
+
+		if p.curTokenIs(token.ELLIPSIS) {
+			if !p.expectPeek(token.IDENT) {
+				return nil
+			}
+
+			pattern.Rest = &ast.Identifier{
+				Token: p.curToken,
+				Value: p.curToken.Literal,
+			}
+
+			// The rest element must be the last one
+			break
+		}
+
+		element := p.parsePattern()
+		if element == nil {
+			return nil
+		}
+		pattern.Elements = append(pattern.Elements, element)
+
+		if !p.peekTokenIs(token.RBRACKET) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+
+	if !p.expectPeek(token.RBRACKET) {
+		return nil
+	}
+
+	return pattern
+}
+
+// Parses Circle(r), Shape.Circle(r) or Shape.Empty. Without parentheses only the variant is checked
+func (p *Parser) parseConstructorPattern() ast.Pattern {
+	pattern := &ast.ConstructorPattern{
+		Token: p.curToken,
+		Name: &ast.Identifier{
+			Token: p.curToken,
+			Value: p.curToken.Literal,
+		},
+	}
+
+	if p.peekTokenIs(token.DOT) {
+		p.nextToken()
+
+		if !p.expectPeek(token.IDENT) {
+			return nil
+		}
+
+		pattern.Qualifier = pattern.Name
+		pattern.Name = &ast.Identifier{
+			Token: p.curToken,
+			Value: p.curToken.Literal,
+		}
+	}
+
+	if !p.peekTokenIs(token.LPAREN) {
+		return pattern
+	}
+
+	p.nextToken()
+
+	pattern.Arguments = []ast.Pattern{}
+
+	for !p.peekTokenIs(token.RPAREN) {
+		p.nextToken()
+
+		argument := p.parsePattern()
+		if argument == nil {
+			return nil
+		}
+
+		pattern.Arguments = append(pattern.Arguments, argument)
+
+		if !p.peekTokenIs(token.RPAREN) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+
+	p.nextToken()
+
+	return pattern
+}
+
+func (p *Parser) parseHashPattern() ast.Pattern {
+	pattern := &ast.HashPattern{
+		Token: p.curToken,
+	}
+
+	for !p.peekTokenIs(token.RBRACE) {
+		p.nextToken()
+
+		var key ast.Expression
+
+		switch p.curToken.Type {
+		case token.IDENT:
+			key = p.parseIdentifier()
+		case token.STRING:
+			key = p.parseStringLiteral()
+		case token.INT:
+			key = p.parseIntegerLiteral()
+		case token.TRUE, token.FALSE:
+			key = p.parseBoolean()
+		default:
+			msg := fmt.Sprintf("expected hash pattern key, got %s", p.curToken.Type)
+			p.errors = append(p.errors, msg)
+			return nil
+		}
+
+		if key == nil {
+			return nil
+		}
+
+		pair := ast.HashPatternPair{Key: key}
+
+		if p.peekTokenIs(token.COLON) {
+			p.nextToken()
+			p.nextToken()
+
+			pair.Value = p.parsePattern()
+			if pair.Value == nil {
+				return nil
+			}
+		} else if ident, ok := key.(*ast.Identifier); ok {
+			// Shorthand {name} binds the "name" key to an identifier with the same name
+			pair.Value = ident
+		} else {
+			msg := fmt.Sprintf("expected : after hash pattern key %s", key.String())
+			p.errors = append(p.errors, msg)
+			return nil
+		}
+
+		pattern.Pairs = append(pattern.Pairs, pair)
+
+		if !p.peekTokenIs(token.RBRACE) && !p.expectPeek(token.COMMA) {
+			return nil
+		}
+	}
+
+	if !p.expectPeek(token.RBRACE) {
 		return nil
 	}
 
-	return identifiers
+	return pattern
 }
 
 func (p *Parser) parseCallExpression(fn ast.Expression) ast.Expression {
@@ -434,6 +1331,11 @@ func (p *Parser) parseCallExpression(fn ast.Expression) ast.Expression {
 func (p *Parser) parseExpressionList(end token.TokenType) []ast.Expression {
 	args := []ast.Expression{}
 
+	// Arrow functions are unambiguous inside the list, even within a match guard
+	noArrowFunctions := p.noArrowFunctions
+	p.noArrowFunctions = false
+	defer func() { p.noArrowFunctions = noArrowFunctions }()
+
 	if p.peekTokenIs(end) {
 		p.nextToken()
 		return args
@@ -455,6 +1357,7 @@ func (p *Parser) parseExpressionList(end token.TokenType) []ast.Expression {
 	return args
 }
 
+// Parses both indexing, e.g. a[1], and slicing, e.g. a[1:3], a[1:] or a[:3]
 func (p *Parser) parseIndexExpression(left ast.Expression) ast.Expression {
 	exp := &ast.IndexExpression{
 		Token: p.curToken,
@@ -463,8 +1366,37 @@ func (p *Parser) parseIndexExpression(left ast.Expression) ast.Expression {
 
 	p.nextToken()
 
+	if p.curTokenIs(token.COLON) {
+		return p.parseSliceExpression(exp.Token, left, nil)
+	}
+
 	exp.Index = p.parseExpression(LOWEST)
 
+	if p.peekTokenIs(token.COLON) {
+		p.nextToken()
+		return p.parseSliceExpression(exp.Token, left, exp.Index)
+	}
+
+	if !p.expectPeek(token.RBRACKET) {
+		return nil
+	}
+
+	return exp
+}
+
+// Parses the rest of a slice from its colon on
+func (p *Parser) parseSliceExpression(tok token.Token, left ast.Expression, start ast.Expression) ast.Expression {
+	exp := &ast.SliceExpression{
+		Token: tok,
+		Left:  left,
+		Start: start,
+	}
+
+	if !p.peekTokenIs(token.RBRACKET) {
+		p.nextToken()
+		exp.End = p.parseExpression(LOWEST)
+	}
+
 	if !p.expectPeek(token.RBRACKET) {
 		return nil
 	}
@@ -472,12 +1404,69 @@ func (p *Parser) parseIndexExpression(left ast.Expression) ast.Expression {
 	return exp
 }
 
+func (p *Parser) parseMemberExpression(object ast.Expression) ast.Expression {
+	exp := &ast.MemberExpression{
+		Token:  p.curToken,
+		Object: object,
+	}
+
+	// Keywords are plain names after a dot, so hashes can have keys such as "if" or "match"
+	if token.IsKeyword(p.peekToken) {
+		p.nextToken()
+	} else if !p.expectPeek(token.IDENT) {
+		return nil
+	}
+
+	exp.Property = &ast.Identifier{
+		Token: token.Token{Type: token.IDENT, Literal: p.curToken.Literal},
+		Value: p.curToken.Literal,
+	}
+
+	return exp
+}
+
+// Parses the link after ?., which is a property, an index or the arguments of a call: a?.b, a?.[k]
+// or f?.(x)
+func (p *Parser) parseOptionalChain(left ast.Expression) ast.Expression {
+	switch {
+	case p.peekTokenIs(token.IDENT) || token.IsKeyword(p.peekToken):
+		exp := p.parseMemberExpression(left).(*ast.MemberExpression)
+		exp.Optional = true
+
+		return exp
+	case p.peekTokenIs(token.LBRACKET):
+		p.nextToken()
+
+		switch exp := p.parseIndexExpression(left).(type) {
+		case *ast.IndexExpression:
+			exp.Optional = true
+			return exp
+		case *ast.SliceExpression:
+			msg := fmt.Sprintf("optional slicing is not supported: %s", exp.String())
+			p.errors = append(p.errors, msg)
+		}
+
+		return nil
+	case p.peekTokenIs(token.LPAREN):
+		p.nextToken()
+
+		exp := p.parseCallExpression(left).(*ast.CallExpression)
+		exp.Optional = true
+
+		return exp
+	default:
+		msg := fmt.Sprintf("expected property, [ or ( after ?., got %s", p.peekToken.Type)
+		p.errors = append(p.errors, msg)
+		return nil
+	}
+}
+
 func (p *Parser) parseHashLiteral() ast.Expression {
 	hash := &ast.HashLiteral{
 		Token: p.curToken,
 	}
 
-	hash.Pairs = make(map[ast.Expression]ast.Expression)
+	hash.Pairs = []ast.HashLiteralPair{}
 
 	for !p.peekTokenIs(token.RBRACE) {
 		p.nextToken()
@@ -490,7 +1479,7 @@ func (p *Parser) parseHashLiteral() ast.Expression {
 		p.nextToken()
 		value := p.parseExpression(LOWEST)
 
-		hash.Pairs[key] = value
+		hash.Pairs = append(hash.Pairs, ast.HashLiteralPair{Key: key, Value: value})
 
 		if !p.peekTokenIs(token.RBRACE) && !p.expectPeek(token.COMMA) {
 			return nil
@@ -517,16 +1506,21 @@ func New(l *lexer.Lexer) *Parser {
 	p.prefixParseFns = make(map[token.TokenType]prefixParseFn)
 	p.registerPrefix(token.IDENT, p.parseIdentifier)
 	p.registerPrefix(token.INT, p.parseIntegerLiteral)
+	p.registerPrefix(token.FLOAT, p.parseFloatLiteral)
 	p.registerPrefix(token.STRING, p.parseStringLiteral)
 	p.registerPrefix(token.BANG, p.parsePrefixExpression)
 	p.registerPrefix(token.MINUS, p.parsePrefixExpression)
 	p.registerPrefix(token.TRUE, p.parseBoolean)
 	p.registerPrefix(token.FALSE, p.parseBoolean)
+	p.registerPrefix(token.NULL, p.parseNullLiteral)
 	p.registerPrefix(token.LPAREN, p.parseGroupedExpression)
 	p.registerPrefix(token.LBRACKET, p.parseArrayLiteral)
 	p.registerPrefix(token.LBRACE, p.parseHashLiteral)
 	p.registerPrefix(token.IF, p.parseIfExpression)
 	p.registerPrefix(token.FUNCTION, p.parseFunctionLiteral)
+	p.registerPrefix(token.MATCH, p.parseMatchExpression)
+	p.registerPrefix(token.THROW, p.parseThrowExpression)
+	p.registerPrefix(token.TRY, p.parseTryExpression)
 
 	p.infixParseFns = make(map[token.TokenType]infixParseFn)
 	p.registerInfix(token.EQ, p.parseInfixExpression)
@@ -537,8 +1531,22 @@ func New(l *lexer.Lexer) *Parser {
 	p.registerInfix(token.MINUS, p.parseInfixExpression)
 	p.registerInfix(token.SLASH, p.parseInfixExpression)
 	p.registerInfix(token.ASTERISK, p.parseInfixExpression)
+	p.registerInfix(token.PERCENT, p.parseInfixExpression)
+	p.registerInfix(token.NULLISH, p.parseInfixExpression)
+	p.registerInfix(token.PIPE, p.parsePipeExpression)
+	p.registerInfix(token.QUESTION, p.parseTernaryExpression)
+	p.registerInfix(token.ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.PLUS_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.MINUS_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.ASTERISK_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.SLASH_ASSIGN, p.parseAssignExpression)
+	p.registerInfix(token.PERCENT_ASSIGN, p.parseAssignExpression)
 	p.registerInfix(token.LPAREN, p.parseCallExpression)
+	p.registerInfix(token.RANGE, p.parseRangeExpression)
+	p.registerInfix(token.RANGE_EQ, p.parseRangeExpression)
 	p.registerInfix(token.LBRACKET, p.parseIndexExpression)
+	p.registerInfix(token.DOT, p.parseMemberExpression)
+	p.registerInfix(token.OPTIONAL, p.parseOptionalChain)
 
 	return p
 }
@@ -43,6 +43,102 @@ func TestLetStatements(t *testing.T) {
 	}
 }
 
+func TestConstStatements(t *testing.T) {
+	input := "const x = 5;"
+
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program.Statements does not contain 1 statement, got %d", len(program.Statements))
+	}
+
+	stmt, ok := program.Statements[0].(*ast.LetStatement)
+	if !ok {
+		t.Fatalf("stmt not *ast.LetStatement. got %T", program.Statements[0])
+	}
+
+	if !stmt.IsConst() {
+		t.Errorf("stmt.IsConst() not true")
+	}
+
+	if stmt.Name.String() != "x" {
+		t.Errorf("stmt.Name.String() not 'x'. got %q", stmt.Name.String())
+	}
+
+	testLiteralExpression(t, stmt.Value, int64(5))
+
+	if stmt.String() != "const x = 5;" {
+		t.Errorf("stmt.String() wrong. got %q", stmt.String())
+	}
+}
+
+func TestDestructuringLetStatements(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"let [a, b] = arr;", "let [a, b] = arr;"},
+		{"let [a, ...tail] = arr;", "let [a, ...tail] = arr;"},
+		{"let [...all] = arr;", "let [...all] = arr;"},
+		{"let [] = arr;", "let [] = arr;"},
+		{"let [a, [b, c]] = arr;", "let [a, [b, c]] = arr;"},
+		{"let {name, age: years} = person;", "let {name, age: years} = person;"},
+		{`let {"first name": first, 0: zero} = h;`, "let {first name: first, 0: zero} = h;"},
+		{"let {tags: [first, ...others]} = post;", "let {tags: [first, ...others]} = post;"},
+		{"const [a, b] = arr;", "const [a, b] = arr;"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statement, got %d", len(program.Statements))
+		}
+
+		if program.String() != tt.expected {
+			t.Errorf("expected %q, got %q", tt.expected, program.String())
+		}
+	}
+}
+
+func TestPatternParsingErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"let * = x;", "expected pattern, got *"},
+		{"let [a, ...b, c] = x;", "expected next token to be ], got ,"},
+		{"let [...] = x;", "expected next token to be IDENT, got ]"},
+		{`let {"a"} = x;`, "expected : after hash pattern key a"},
+		{"let {[a]: b} = x;", "expected hash pattern key, got ["},
+		{"fn(+) { 1 }", "expected pattern, got +"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Errorf("expected parser errors for %q", tt.input)
+			continue
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong parser error. want %q, got %q", tt.expected, errors[0])
+		}
+	}
+}
+
 func TestReturnStatements(t *testing.T) {
 	tests := []struct {
 		input         string
@@ -157,8 +253,8 @@ func testLetStatement(t *testing.T, s ast.Statement, name string) bool {
 		return false
 	}
 
-	if letStmt.Name.Value != name {
-		t.Errorf("letStmt.Name.Value not '%s'. got '%s'", name, letStmt.Name.Value)
+	if letStmt.Name.String() != name {
+		t.Errorf("letStmt.Name.String() not '%s'. got '%s'", name, letStmt.Name.String())
 		return false
 	}
 
@@ -214,6 +310,40 @@ func TestIntegerExpression(t *testing.T) {
 	testLiteralExpression(t, stmt.Expression, int64(5))
 }
 
+func TestFloatExpression(t *testing.T) {
+	input := "3.25;"
+
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program has not enough statements. got %d", len(program.Statements))
+	}
+
+	stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+
+	if !ok {
+		t.Fatalf("program.Statements[0] is not ast.ExpressionStatement. got %T", program.Statements[0])
+	}
+
+	literal, ok := stmt.Expression.(*ast.FloatLiteral)
+
+	if !ok {
+		t.Fatalf("stmt.Expression is not *ast.FloatLiteral. got %T", stmt.Expression)
+	}
+
+	if literal.Value != 3.25 {
+		t.Errorf("literal.Value not %f. got %f", 3.25, literal.Value)
+	}
+
+	if literal.TokenLiteral() != "3.25" {
+		t.Errorf("literal.TokenLiteral not %s. got %s", "3.25", literal.TokenLiteral())
+	}
+}
+
 func TestParsingPrefixExpressions(t *testing.T) {
 	prefixTests := []struct {
 		input        string
@@ -282,6 +412,241 @@ func TestParsingIndexExpressions(t *testing.T) {
 	}
 }
 
+func TestParsingSliceExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"a[1:3]", "(a[1:3])"},
+		{"a[1:]", "(a[1:])"},
+		{"a[:3]", "(a[:3])"},
+		{"a[:]", "(a[:])"},
+		{"a[-2:n - 1]", "(a[(-2):(n - 1)])"},
+		{"a[1:2][0]", "((a[1:2])[0])"},
+		{"s[0] + s[1:]", "((s[0]) + (s[1:]))"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		stmt := program.Statements[0].(*ast.ExpressionStatement)
+
+		if stmt.Expression.String() != tt.expected {
+			t.Errorf("wrong expression. got=%q, want=%q", stmt.Expression.String(), tt.expected)
+		}
+	}
+
+	l := lexer.New("a[:x]")
+	p := New(l)
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	slice, ok := program.Statements[0].(*ast.ExpressionStatement).Expression.(*ast.SliceExpression)
+	if !ok {
+		t.Fatalf("expression is not *ast.SliceExpression. got %T", program.Statements[0].(*ast.ExpressionStatement).Expression)
+	}
+
+	if slice.Start != nil {
+		t.Errorf("slice.Start is not nil. got %s", slice.Start)
+	}
+
+	testIdentifier(t, slice.End, "x")
+}
+
+func TestParsingRangeExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"0..10", "(0..10)"},
+		{"0..=10", "(0..=10)"},
+		{"0..n + 1", "(0..(n + 1))"},
+		{"a + 1..b * 2", "((a + 1)..(b * 2))"},
+		{"10..0 step -2", "(10..0 step (-2))"},
+		{"0..=n step k + 1", "(0..=n step (k + 1))"},
+		{"(0..10)[2]", "((0..10)[2])"},
+		{"0..10 == r", "((0..10) == r)"},
+		{"let step = 2; step", "let step = 2;step"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if program.String() != tt.expected {
+			t.Errorf("wrong program. got=%q, want=%q", program.String(), tt.expected)
+		}
+	}
+
+	l := lexer.New("1..=5")
+	p := New(l)
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	rangeExp, ok := program.Statements[0].(*ast.ExpressionStatement).Expression.(*ast.RangeExpression)
+	if !ok {
+		t.Fatalf("expression is not *ast.RangeExpression. got %T", program.Statements[0].(*ast.ExpressionStatement).Expression)
+	}
+
+	if !rangeExp.Inclusive {
+		t.Errorf("rangeExp.Inclusive is not true")
+	}
+
+	if rangeExp.Step != nil {
+		t.Errorf("rangeExp.Step is not nil. got %s", rangeExp.Step)
+	}
+
+	testIntegerLiteral(t, rangeExp.Start, 1)
+	testIntegerLiteral(t, rangeExp.End, 5)
+}
+
+func TestParsingOptionalChains(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"a?.b", "(a?.b)"},
+		{"a?.[k]", "(a?.[k])"},
+		{"f?.(x, y)", "f?.(x, y)"},
+		{"a?.b.c", "((a?.b).c)"},
+		{"a.b?.c[0]", "(((a.b)?.c)[0])"},
+		{"a?.b?.(1)?.[2]", "((a?.b)?.(1)?.[2])"},
+		{"a?.b ?? c", "((a?.b) ?? c)"},
+		{"a ?? b ?? c", "((a ?? b) ?? c)"},
+		{"a ?? b == c", "(a ?? (b == c))"},
+		{"x = a ?? b", "(x = (a ?? b))"},
+		{"a == null", "(a == null)"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if program.String() != tt.expected {
+			t.Errorf("wrong program. got=%q, want=%q", program.String(), tt.expected)
+		}
+	}
+}
+
+func TestParsingPipeExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"xs |> f", "(xs |> f)"},
+		{"xs |> filter(f) |> map(g)", "((xs |> filter(f)) |> map(g))"},
+		{"1 + 2 |> double()", "((1 + 2) |> double())"},
+		{"0..10 |> sum", "((0..10) |> sum)"},
+		{"xs |> len() > 2", "((xs |> len()) > 2)"},
+		{"xs |> len() == 2", "((xs |> len()) == 2)"},
+		{"xs |> f ?? g", "((xs |> f) ?? g)"},
+		{"x = xs |> f()", "(x = (xs |> f()))"},
+		{"xs |> h.f(1)", "(xs |> (h.f)(1))"},
+		{"xs |> fn(x) { x }", "(xs |> fn(x)x)"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if program.String() != tt.expected {
+			t.Errorf("wrong program. got=%q, want=%q", program.String(), tt.expected)
+		}
+	}
+}
+
+func TestOptionalChainParsingErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"a?.1", "expected property, [ or ( after ?., got INT"},
+		{"a?.[1:2]", "optional slicing is not supported: (a[1:2])"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Errorf("expected parser errors for %q", tt.input)
+			continue
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong parser error. want %q, got %q", tt.expected, errors[0])
+		}
+	}
+}
+
+func TestParsingMemberExpressions(t *testing.T) {
+	input := "person.name"
+
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	member, ok := stmt.Expression.(*ast.MemberExpression)
+
+	if !ok {
+		t.Fatalf("stmt.Expression is not *ast.MemberExpression. got %T", stmt.Expression)
+	}
+
+	if !testIdentifier(t, member.Object, "person") {
+		return
+	}
+
+	testIdentifier(t, member.Property, "name")
+}
+
+func TestParsingChainedMemberExpressions(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"config.db.host", "((config.db).host)"},
+		{"config.db.hosts[0].name", "((((config.db).hosts)[0]).name)"},
+		{"a.b + c.d * 2", "((a.b) + ((c.d) * 2))"},
+		{"-a.b", "(-(a.b))"},
+		{"a.b(1).c", "((a.b)(1).c)"},
+		{"h.if", "(h.if)"},
+		{"h.match.null", "((h.match).null)"},
+		{"h?.true", "(h?.true)"},
+		{"a.b.c = 1", "(((a.b).c) = 1)"},
+		{"a.b += 1", "((a.b) += 1)"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if program.String() != tt.expected {
+			t.Errorf("wrong program. got=%q, want=%q", program.String(), tt.expected)
+		}
+	}
+}
+
 func TestParsingHashLiteralsStringKeys(t *testing.T) {
 	input := `{"one": 1, "two": 2, "three": 3};`
 
@@ -308,16 +673,16 @@ func TestParsingHashLiteralsStringKeys(t *testing.T) {
 		"three": 3,
 	}
 
-	for key, value := range hash.Pairs {
-		literal, ok := key.(*ast.StringLiteral)
+	for _, pair := range hash.Pairs {
+		literal, ok := pair.Key.(*ast.StringLiteral)
 
 		if !ok {
-			t.Errorf("key is not *ast.StringLiteral. got %T", key)
+			t.Errorf("key is not *ast.StringLiteral. got %T", pair.Key)
 			continue
 		}
 
 		expectedValue := expected[literal.String()]
-		testIntegerLiteral(t, value, expectedValue)
+		testIntegerLiteral(t, pair.Value, expectedValue)
 	}
 }
 
@@ -374,11 +739,11 @@ func TestParsingHashLiteralsWithExpressions(t *testing.T) {
 		},
 	}
 
-	for key, value := range hash.Pairs {
-		literal, ok := key.(*ast.StringLiteral)
+	for _, pair := range hash.Pairs {
+		literal, ok := pair.Key.(*ast.StringLiteral)
 
 		if !ok {
-			t.Errorf("key is not *ast.StringLiteral. got %T", key)
+			t.Errorf("key is not *ast.StringLiteral. got %T", pair.Key)
 			continue
 		}
 
@@ -389,7 +754,40 @@ func TestParsingHashLiteralsWithExpressions(t *testing.T) {
 			continue
 		}
 
-		testFn(value)
+		testFn(pair.Value)
+	}
+}
+
+func TestParsingHashLiteralsKeepSourceOrder(t *testing.T) {
+	input := `{"b": 1, "a": 2, 3: 3, "c": 4}`
+
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	stmt := program.Statements[0].(*ast.ExpressionStatement)
+	hash, ok := stmt.Expression.(*ast.HashLiteral)
+
+	if !ok {
+		t.Fatalf("stmt.Expression is not *ast.HashLiteral. got %T", stmt.Expression)
+	}
+
+	expected := []string{"b", "a", "3", "c"}
+
+	if len(hash.Pairs) != len(expected) {
+		t.Fatalf("hash.Pairs does not contain %d pairs. got %d", len(expected), len(hash.Pairs))
+	}
+
+	for i, key := range expected {
+		if hash.Pairs[i].Key.String() != key {
+			t.Errorf("hash.Pairs[%d] has wrong key. got=%q, want=%q", i, hash.Pairs[i].Key.String(), key)
+		}
+	}
+
+	if hash.String() != "{b: 1, a: 2, 3: 3, c: 4}" {
+		t.Errorf("hash.String() wrong. got=%q", hash.String())
 	}
 }
 
@@ -435,11 +833,22 @@ func testIdentifier(t *testing.T, expression ast.Expression, value string) bool
 	return true
 }
 
-func testLiteralExpression(t *testing.T, expression ast.Expression, expected any) bool {
-	switch v := expected.(type) {
-	case int:
-		return testIntegerLiteral(t, expression, int64(v))
-	case int64:
+func testIdentifierPattern(t *testing.T, pattern ast.Pattern, value string) bool {
+	ident, ok := pattern.(*ast.Identifier)
+
+	if !ok {
+		t.Errorf("pattern not *ast.Identifier. got %T", pattern)
+		return false
+	}
+
+	return testIdentifier(t, ident, value)
+}
+
+func testLiteralExpression(t *testing.T, expression ast.Expression, expected any) bool {
+	switch v := expected.(type) {
+	case int:
+		return testIntegerLiteral(t, expression, int64(v))
+	case int64:
 		return testIntegerLiteral(t, expression, v)
 	case string:
 		return testIdentifier(t, expression, v)
@@ -507,6 +916,7 @@ func TestParsingInfixExpressions(t *testing.T) {
 		{"5 - 5", 5, "-", 5},
 		{"5 * 5", 5, "*", 5},
 		{"5 / 5", 5, "/", 5},
+		{"5 % 5", 5, "%", 5},
 		{"5 > 5", 5, ">", 5},
 		{"5 < 5", 5, "<", 5},
 		{"5 == 5", 5, "==", 5},
@@ -543,6 +953,8 @@ func TestOperatorPrecedenceParsing(t *testing.T) {
 		expected string
 	}{
 		{"-a * b", "((-a) * b)"},
+		{"1.5 + 2 * 0.5", "(1.5 + (2 * 0.5))"},
+		{"-1.5 * x", "((-1.5) * x)"},
 		{"!-a", "(!(-a))"},
 		{"a + b + c", "((a + b) + c)"},
 		{"a + b - c", "((a + b) - c)"},
@@ -568,6 +980,16 @@ func TestOperatorPrecedenceParsing(t *testing.T) {
 		{"add(a + b + c * d / f + g)", "add((((a + b) + ((c * d) / f)) + g))"},
 		{"a * [1, 2, 3, 4][b * c] * d", "((a * ([1, 2, 3, 4][(b * c)])) * d)"},
 		{"add(a * b[2], b[1], 2 * [1, 2][1])", "add((a * (b[2])), (b[1]), (2 * ([1, 2][1])))"},
+		{"a + b % c", "(a + (b % c))"},
+		{"a = b + c", "(a = (b + c))"},
+		{"a = b = c", "(a = (b = c))"},
+		{"a += b * c", "(a += (b * c))"},
+		{"a = b == c", "(a = (b == c))"},
+		{"a.b.c", "((a.b).c)"},
+		{"a.b[c].d", "(((a.b)[c]).d)"},
+		{"a.b(c) + d", "((a.b)(c) + d)"},
+		{"a[0] = b.c", "((a[0]) = (b.c))"},
+		{"a.b += 1", "((a.b) += 1)"},
 	}
 
 	for _, tt := range tests {
@@ -713,6 +1135,116 @@ func TestIfElseExpression(t *testing.T) {
 	}
 }
 
+func TestElseIfExpression(t *testing.T) {
+	input := `if (x < 0) { -1 } else if (x == 0) { 0 } else if (x < 10) { 1 } else { 2 }`
+
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program has not enough statements. got %d", len(program.Statements))
+	}
+
+	ifExpr := program.Statements[0].(*ast.ExpressionStatement).Expression.(*ast.IfExpression)
+	conditions := []struct {
+		operator string
+		right    any
+	}{
+		{"<", 0},
+		{"==", 0},
+		{"<", 10},
+	}
+
+	for i, condition := range conditions {
+		if !testInfixExpression(t, ifExpr.Condition, "x", condition.operator, condition.right) {
+			return
+		}
+
+		if ifExpr.Alternative == nil || len(ifExpr.Alternative.Statements) != 1 {
+			t.Fatalf("if %d has no single statement alternative", i)
+		}
+
+		alternative := ifExpr.Alternative.Statements[0].(*ast.ExpressionStatement).Expression
+
+		if i == len(conditions)-1 {
+			testIntegerLiteral(t, alternative, 2)
+			return
+		}
+
+		next, ok := alternative.(*ast.IfExpression)
+		if !ok {
+			t.Fatalf("alternative %d is not *ast.IfExpression. got %T", i, alternative)
+		}
+
+		ifExpr = next
+	}
+}
+
+func TestTernaryExpressionParsing(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"a ? b : c", "(a ? b : c)"},
+		{"a ? b : c ? d : e", "(a ? b : (c ? d : e))"},
+		{"a ? b ? c : d : e", "(a ? (b ? c : d) : e)"},
+		{"x > 0 ? x : -x", "((x > 0) ? x : (-x))"},
+		{"a == b ? 1 + 2 : 3 * 4", "((a == b) ? (1 + 2) : (3 * 4))"},
+		{"a ?? b ? c : d", "((a ?? b) ? c : d)"},
+		{"xs |> len() > 0 ? 1 : 2", "(((xs |> len()) > 0) ? 1 : 2)"},
+		{"x = a ? b : c", "(x = (a ? b : c))"},
+		{"a ? x = 1 : y", "(a ? (x = 1) : y)"},
+		{"f(a ? b : c, d)", "f((a ? b : c), d)"},
+		{"[a ? 1 : 2][0]", "([(a ? 1 : 2)][0])"},
+		{"xs[i > 0 ? i : 0]", "(xs[((i > 0) ? i : 0)])"},
+		{"(a ? b : c) + 1", "((a ? b : c) + 1)"},
+		{"a ? b?.c : d", "(a ? (b?.c) : d)"},
+		{"1 + if (a) { 2 } else { 3 }", "(1 + ifa 2else 3)"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if program.String() != tt.expected {
+			t.Errorf("wrong program. got=%q, want=%q", program.String(), tt.expected)
+		}
+	}
+}
+
+func TestTernaryExpressionParsingErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"a ? b", "expected next token to be :, got EOF"},
+		{"a ? b c", "expected next token to be :, got IDENT"},
+		{"if (a) { 1 } else if { 2 }", "expected next token to be (, got {"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Errorf("expected parser errors for %q", tt.input)
+			continue
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong parser error. want %q, got %q", tt.expected, errors[0])
+		}
+	}
+}
+
 func TestFunctionLiteralParsing(t *testing.T) {
 	input := `fn(x, y) { x + y; }`
 
@@ -740,8 +1272,8 @@ func TestFunctionLiteralParsing(t *testing.T) {
 		t.Fatalf("function literal parameters wrong. want 2, got %d", len(fn.Parameters))
 	}
 
-	testLiteralExpression(t, fn.Parameters[0], "x")
-	testLiteralExpression(t, fn.Parameters[1], "y")
+	testIdentifierPattern(t, fn.Parameters[0], "x")
+	testIdentifierPattern(t, fn.Parameters[1], "y")
 
 	if len(fn.Body.Statements) != 1 {
 		t.Fatalf("function literal body statements wrong. want 1, got %d", len(fn.Body.Statements))
@@ -755,6 +1287,94 @@ func TestFunctionLiteralParsing(t *testing.T) {
 	testInfixExpression(t, bodyStmt.Expression, "x", "+", "y")
 }
 
+func TestArrowFunctionParsing(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedParams []string
+		expectedBody   string
+	}{
+		{"x => x * 2", []string{"x"}, "(x * 2)"},
+		{"() => 1", []string{}, "1"},
+		{"(x) => x", []string{"x"}, "x"},
+		{"(a, b) => a + b", []string{"a", "b"}, "(a + b)"},
+		{"(a, b) => { let c = a + b; c * 2 }", []string{"a", "b"}, "let c = (a + b);(c * 2)"},
+		{"([a, ...rest], {name}) => rest", []string{"[a, ...rest]", "{name}"}, "rest"},
+		{"x => y => x + y", []string{"x"}, "fn(y)(x + y)"},
+		{"x => x > 0 ? x : -x", []string{"x"}, "((x > 0) ? x : (-x))"},
+		{"(x) => (x + 1) * 2", []string{"x"}, "((x + 1) * 2)"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("%s: program has wrong number of statements. got %d", tt.input, len(program.Statements))
+		}
+
+		function, ok := program.Statements[0].(*ast.ExpressionStatement).Expression.(*ast.FunctionLiteral)
+		if !ok {
+			t.Errorf("%s: expression is not *ast.FunctionLiteral. got %T", tt.input, program.Statements[0].(*ast.ExpressionStatement).Expression)
+			continue
+		}
+
+		if len(function.Parameters) != len(tt.expectedParams) {
+			t.Errorf("%s: wrong number of parameters. got %d, want %d", tt.input, len(function.Parameters), len(tt.expectedParams))
+			continue
+		}
+
+		for i, param := range tt.expectedParams {
+			if function.Parameters[i].String() != param {
+				t.Errorf("%s: wrong parameter %d. got %q, want %q", tt.input, i, function.Parameters[i].String(), param)
+			}
+		}
+
+		if function.Body.String() != tt.expectedBody {
+			t.Errorf("%s: wrong body. got %q, want %q", tt.input, function.Body.String(), tt.expectedBody)
+		}
+	}
+}
+
+func TestArrowFunctionDisambiguation(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"(a)", "a"},
+		{"(a + b) * c", "((a + b) * c)"},
+		{"(f(x)[0])", "(f(x)[0])"},
+		{"((a, b) => a)(1, 2)", "fn(a, b)a(1, 2)"},
+		{"map(xs, x => x * 2)", "map(xs, fn(x)(x * 2))"},
+		{"map(xs, (x) => x)", "map(xs, fn(x)x)"},
+		{"xs |> filter(x => x > 1)", "(xs |> filter(fn(x)(x > 1)))"},
+		{"let f = x => x + 1; f(2)", "let f = fn(x)(x + 1);f(2)"},
+		{"match (x) { n if n > 0 => n, _ => 0 }", "match (x) { n if (n > 0) => n, _ => 0 }"},
+		{"match (x) { n if (n) => n }", "match (x) { n if n => n }"},
+		{"match (x) { xs if any(xs, y => y > 0) => 1 }", "match (x) { xs if any(xs, fn(y)(y > 0)) => 1 }"},
+		{"match (x) { n => m => n + m }", "match (x) { n => fn(m)(n + m) }"},
+		{"(((a)))", "a"},
+		{"((a + b) * (c - d))", "((a + b) * (c - d))"},
+		{"([a, ...rest], {k: v}) => a", "fn([a, ...rest], {k: v})a"},
+		{"(Circle(r), -1) => r", "fn(Circle(r), (-1))r"},
+		{"(Circle(r) + 1)", "(Circle(r) + 1)"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if program.String() != tt.expected {
+			t.Errorf("wrong program. got=%q, want=%q", program.String(), tt.expected)
+		}
+	}
+}
+
 func TestFunctionParametersParsing(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -764,6 +1384,7 @@ func TestFunctionParametersParsing(t *testing.T) {
 		{"fn(x) {};", []string{"x"}},
 		{"fn(x, y) {};", []string{"x", "y"}},
 		{"fn(x, y, z) {};", []string{"x", "y", "z"}},
+		{"fn([x, y], {z}) {};", []string{"[x, y]", "{z}"}},
 	}
 
 	for _, tt := range tests {
@@ -780,8 +1401,10 @@ func TestFunctionParametersParsing(t *testing.T) {
 			t.Fatalf("function literal parameters wrong. want %d, got %d", len(tt.expected), len(fn.Parameters))
 		}
 
-		for i, ident := range tt.expected {
-			testLiteralExpression(t, fn.Parameters[i], ident)
+		for i, param := range tt.expected {
+			if fn.Parameters[i].String() != param {
+				t.Errorf("parameter %d wrong. want %q, got %q", i, param, fn.Parameters[i].String())
+			}
 		}
 	}
 }
@@ -821,3 +1444,523 @@ func TestCallExpressionParsing(t *testing.T) {
 	testInfixExpression(t, expression.Arguments[1], int64(2), "*", int64(3))
 	testInfixExpression(t, expression.Arguments[2], int64(4), "+", int64(5))
 }
+
+func TestAssignExpressionParsing(t *testing.T) {
+	tests := []struct {
+		input    string
+		target   string
+		operator string
+		value    any
+	}{
+		{"x = 5;", "x", "=", 5},
+		{"x += 5;", "x", "+=", 5},
+		{"x -= y;", "x", "-=", "y"},
+		{"x *= 5;", "x", "*=", 5},
+		{"x /= 5;", "x", "/=", 5},
+		{"x %= 5;", "x", "%=", 5},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program has not enough statements. got %d", len(program.Statements))
+		}
+
+		stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+		if !ok {
+			t.Fatalf("program.Statements[0] is not ast.ExpressionStatement. got %T", program.Statements[0])
+		}
+
+		assign, ok := stmt.Expression.(*ast.AssignExpression)
+		if !ok {
+			t.Fatalf("stmt.Expression is not *ast.AssignExpression. got %T", stmt.Expression)
+		}
+
+		if !testIdentifier(t, assign.Target, tt.target) {
+			return
+		}
+
+		if assign.Operator != tt.operator {
+			t.Errorf("assign.Operator not %s. got %s", tt.operator, assign.Operator)
+		}
+
+		testLiteralExpression(t, assign.Value, tt.value)
+	}
+}
+
+func TestInvalidAssignmentTarget(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"5 = 1;", "invalid assignment target: 5"},
+		{"add(1) += 1;", "invalid assignment target: add(1)"},
+		{"person. = 1;", "expected next token to be IDENT, got ="},
+		{"person?.name = 1;", "invalid assignment target: (person?.name)"},
+		{"list?.[0] += 1;", "invalid assignment target: (list?.[0])"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Errorf("expected parser errors for %q", tt.input)
+			continue
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong parser error. want %q, got %q", tt.expected, errors[0])
+		}
+	}
+}
+
+func TestMatchExpressionParsing(t *testing.T) {
+	input := `match (x) { 1 | -1 => "one", [a, _] if a > 0 => a, {kind: "circle", r} => { r * r }, _ => 0 }`
+
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program has not enough statements. got %d", len(program.Statements))
+	}
+
+	stmt, ok := program.Statements[0].(*ast.ExpressionStatement)
+	if !ok {
+		t.Fatalf("program.Statements[0] is not ast.ExpressionStatement. got %T", program.Statements[0])
+	}
+
+	match, ok := stmt.Expression.(*ast.MatchExpression)
+	if !ok {
+		t.Fatalf("stmt.Expression is not *ast.MatchExpression. got %T", stmt.Expression)
+	}
+
+	if !testIdentifier(t, match.Subject, "x") {
+		return
+	}
+
+	tests := []struct {
+		pattern string
+		guard   string
+		body    string
+	}{
+		{"1 | (-1)", "", "one"},
+		{"[a, _]", "(a > 0)", "a"},
+		{"{kind: circle, r}", "", "(r * r)"},
+		{"_", "", "0"},
+	}
+
+	if len(match.Arms) != len(tests) {
+		t.Fatalf("match.Arms does not contain %d arms. got %d", len(tests), len(match.Arms))
+	}
+
+	for i, tt := range tests {
+		arm := match.Arms[i]
+
+		if arm.Pattern.String() != tt.pattern {
+			t.Errorf("arm %d pattern wrong. want %q, got %q", i, tt.pattern, arm.Pattern.String())
+		}
+
+		guard := ""
+		if arm.Guard != nil {
+			guard = arm.Guard.String()
+		}
+
+		if guard != tt.guard {
+			t.Errorf("arm %d guard wrong. want %q, got %q", i, tt.guard, guard)
+		}
+
+		if arm.Body.String() != tt.body {
+			t.Errorf("arm %d body wrong. want %q, got %q", i, tt.body, arm.Body.String())
+		}
+	}
+
+	if _, ok := match.Arms[2].Body.(*ast.BlockStatement); !ok {
+		t.Errorf("arm 2 body is not *ast.BlockStatement. got %T", match.Arms[2].Body)
+	}
+}
+
+func TestMatchExpressionParsingErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"match x { _ => 1 }", "expected next token to be (, got IDENT"},
+		{"match (x) { -a => 1 }", "expected number after - in pattern, got IDENT"},
+		{"match (x) { 1 2 }", "expected next token to be =>, got INT"},
+		{"match (x) { 1 => 1 2 => 2 }", "expected next token to be ,, got INT"},
+		{"match (x) { * => 1 }", "expected pattern, got *"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Errorf("expected parser errors for %q", tt.input)
+			continue
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong parser error. want %q, got %q", tt.expected, errors[0])
+		}
+	}
+}
+
+func TestTryExpressionParsing(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"try { a } catch (e) { b }", "try a catch (e) b"},
+		{"try { a } catch { b }", "try a catch b"},
+		{"try { a } finally { c }", "try a finally c"},
+		{"try { a } catch ({message}) { message } finally { c }", "try a catch ({message}) message finally c"},
+		{"let x = try { a } catch (e) { b };", "let x = try a catch (e) b;"},
+		{`throw "boom";`, "throw boom"},
+		{"throw a + b;", "throw (a + b)"},
+		{"match (x) { _ => throw x }", "match (x) { _ => throw x }"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if program.String() != tt.expected {
+			t.Errorf("expected %q, got %q", tt.expected, program.String())
+		}
+	}
+}
+
+func TestTryExpressionParsingErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"try { a }", "expected catch or finally after try block, got EOF"},
+		{"try a catch { b }", "expected next token to be {, got IDENT"},
+		{"try { a } catch (e { b }", "expected next token to be ), got {"},
+		{"try { a } finally b", "expected next token to be {, got IDENT"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Errorf("expected parser errors for %q", tt.input)
+			continue
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong parser error. want %q, got %q", tt.expected, errors[0])
+		}
+	}
+}
+
+func TestImportStatementParsing(t *testing.T) {
+	input := `import "path/to/lib" as lib;`
+
+	l := lexer.New(input)
+	p := New(l)
+
+	program := p.ParseProgram()
+	checkParserErrors(t, p)
+
+	if len(program.Statements) != 1 {
+		t.Fatalf("program.Statements does not contain 1 statement, got %d", len(program.Statements))
+	}
+
+	stmt, ok := program.Statements[0].(*ast.ImportStatement)
+	if !ok {
+		t.Fatalf("stmt not *ast.ImportStatement. got %T", program.Statements[0])
+	}
+
+	if stmt.Path.Value != "path/to/lib" {
+		t.Errorf("stmt.Path.Value not 'path/to/lib'. got %q", stmt.Path.Value)
+	}
+
+	testIdentifier(t, stmt.Alias, "lib")
+}
+
+func TestExportStatementParsing(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"export let x = 5;", "export let x = 5;"},
+		{"export const x = 5;", "export const x = 5;"},
+		{"export let [a, b] = pair;", "export let [a, b] = pair;"},
+		{"export struct Point { x, y }", "export struct Point { x, y }"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("program.Statements does not contain 1 statement, got %d", len(program.Statements))
+		}
+
+		if _, ok := program.Statements[0].(*ast.ExportStatement); !ok {
+			t.Fatalf("stmt not *ast.ExportStatement. got %T", program.Statements[0])
+		}
+
+		if program.String() != tt.expected {
+			t.Errorf("expected %q, got %q", tt.expected, program.String())
+		}
+	}
+}
+
+func TestStructStatementParsing(t *testing.T) {
+	tests := []struct {
+		input          string
+		expectedName   string
+		expectedFields []string
+	}{
+		{"struct Point { x, y }", "Point", []string{"x", "y"}},
+		{"struct Point { x, y, };", "Point", []string{"x", "y"}},
+		{"struct Unit {}", "Unit", []string{}},
+		{"struct User {\n name,\n email\n}", "User", []string{"name", "email"}},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("%s: program.Statements does not contain 1 statement, got %d", tt.input, len(program.Statements))
+		}
+
+		stmt, ok := program.Statements[0].(*ast.StructStatement)
+		if !ok {
+			t.Fatalf("%s: stmt not *ast.StructStatement. got %T", tt.input, program.Statements[0])
+		}
+
+		if stmt.Name.Value != tt.expectedName {
+			t.Errorf("%s: wrong name. got %q, want %q", tt.input, stmt.Name.Value, tt.expectedName)
+		}
+
+		if len(stmt.Fields) != len(tt.expectedFields) {
+			t.Fatalf("%s: wrong number of fields. got %d, want %d", tt.input, len(stmt.Fields), len(tt.expectedFields))
+		}
+
+		for i, field := range tt.expectedFields {
+			testIdentifier(t, stmt.Fields[i], field)
+		}
+	}
+
+	program := New(lexer.New("struct Point { x, y } Point(1, 2)")).ParseProgram()
+
+	if len(program.Statements) != 2 {
+		t.Fatalf("program.Statements does not contain 2 statements, got %d", len(program.Statements))
+	}
+
+	if program.String() != "struct Point { x, y }Point(1, 2)" {
+		t.Errorf("wrong program. got %q", program.String())
+	}
+}
+
+func TestStructStatementParsingErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"struct { x }", "expected next token to be IDENT, got {"},
+		{"struct Point x, y", "expected next token to be {, got IDENT"},
+		{"struct Point { x y }", "expected next token to be ,, got IDENT"},
+		{"struct Point { x, 1 }", "expected next token to be IDENT, got INT"},
+		{"struct Point { x, y, x }", "duplicate field x in struct Point"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Errorf("expected parser errors for %q", tt.input)
+			continue
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong parser error. want %q, got %q", tt.expected, errors[0])
+		}
+	}
+}
+
+func TestEnumStatementParsing(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"enum Shape { Circle(r), Rect(w, h), Empty }", "enum Shape { Circle(r), Rect(w, h), Empty }"},
+		{"enum Option { Some(value), None, };", "enum Option { Some(value), None }"},
+		{"enum Never {}", "enum Never {}"},
+		{"enum Unit { Nothing() }", "enum Unit { Nothing() }"},
+		{"enum Color {\n Red,\n Green\n}", "enum Color { Red, Green }"},
+		{"export enum Shape { Empty }", "export enum Shape { Empty }"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		if len(program.Statements) != 1 {
+			t.Fatalf("%s: program.Statements does not contain 1 statement, got %d", tt.input, len(program.Statements))
+		}
+
+		if program.String() != tt.expected {
+			t.Errorf("%s: wrong program. got %q, want %q", tt.input, program.String(), tt.expected)
+		}
+	}
+
+	program := New(lexer.New("enum Shape { Circle(r), Empty }")).ParseProgram()
+
+	stmt, ok := program.Statements[0].(*ast.EnumStatement)
+	if !ok {
+		t.Fatalf("stmt not *ast.EnumStatement. got %T", program.Statements[0])
+	}
+
+	if len(stmt.Variants) != 2 {
+		t.Fatalf("wrong number of variants. got %d, want 2", len(stmt.Variants))
+	}
+
+	testIdentifier(t, stmt.Variants[0].Fields[0], "r")
+
+	if stmt.Variants[1].Fields != nil {
+		t.Errorf("unit variant has fields. got %v", stmt.Variants[1].Fields)
+	}
+}
+
+func TestEnumStatementParsingErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"enum { Empty }", "expected next token to be IDENT, got {"},
+		{"enum Shape Empty", "expected next token to be {, got IDENT"},
+		{"enum Shape { Circle(r) Empty }", "expected next token to be ,, got IDENT"},
+		{"enum Shape { Circle(1) }", "expected next token to be IDENT, got INT"},
+		{"enum Shape { Empty, Empty }", "duplicate variant Empty in enum Shape"},
+		{"enum Shape { Rect(w, w) }", "duplicate field w in variant Rect"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Errorf("expected parser errors for %q", tt.input)
+			continue
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong parser error. want %q, got %q", tt.expected, errors[0])
+		}
+	}
+}
+
+func TestConstructorPatternParsing(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"match (s) { Circle(r) => r }", "Circle(r)"},
+		{"match (s) { Rect(w, _) => w }", "Rect(w, _)"},
+		{"match (s) { Shape.Rect(1, [a]) => a }", "Shape.Rect(1, [a])"},
+		{"match (s) { Shape.Empty => 0 }", "Shape.Empty"},
+		{"match (s) { Nothing() => 0 }", "Nothing()"},
+		{"match (s) { Some(Point(x, y)) | None() => 0 }", "Some(Point(x, y)) | None()"},
+		{"match (s) { {shape: Circle(r)} => r }", "{shape: Circle(r)}"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+
+		program := p.ParseProgram()
+		checkParserErrors(t, p)
+
+		stmt := program.Statements[0].(*ast.ExpressionStatement)
+
+		match, ok := stmt.Expression.(*ast.MatchExpression)
+		if !ok {
+			t.Fatalf("%s: stmt.Expression is not *ast.MatchExpression. got %T", tt.input, stmt.Expression)
+		}
+
+		if match.Arms[0].Pattern.String() != tt.expected {
+			t.Errorf("%s: wrong pattern. got %q, want %q", tt.input, match.Arms[0].Pattern.String(), tt.expected)
+		}
+	}
+
+	program := New(lexer.New("let Circle(r) = c")).ParseProgram()
+
+	let := program.Statements[0].(*ast.LetStatement)
+
+	pattern, ok := let.Name.(*ast.ConstructorPattern)
+	if !ok {
+		t.Fatalf("let.Name is not *ast.ConstructorPattern. got %T", let.Name)
+	}
+
+	if pattern.Qualifier != nil || pattern.Name.Value != "Circle" || len(pattern.Arguments) != 1 {
+		t.Errorf("wrong pattern. got %q", pattern.String())
+	}
+}
+
+func TestModuleStatementParsingErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"import lib as lib;", "expected next token to be STRING, got IDENT"},
+		{`import "lib";`, "expected next token to be AS, got ;"},
+		{`import "lib" as "lib";`, "expected next token to be IDENT, got STRING"},
+		{"export 5;", "expected declaration after export, got INT"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.New(tt.input)
+		p := New(l)
+		p.ParseProgram()
+
+		errors := p.Errors()
+		if len(errors) == 0 {
+			t.Errorf("expected parser errors for %q", tt.input)
+			continue
+		}
+
+		if errors[0] != tt.expected {
+			t.Errorf("wrong parser error. want %q, got %q", tt.expected, errors[0])
+		}
+	}
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/RafaLopesMelo/monkey-lang/internal/evaluator"
 	"github.com/RafaLopesMelo/monkey-lang/internal/lexer"
-	"github.com/RafaLopesMelo/monkey-lang/internal/object"
 	"github.com/RafaLopesMelo/monkey-lang/internal/parser"
 )
 
@@ -15,7 +14,7 @@ const PROMPT = ">> "
 
 func StartRepl(in io.Reader, out io.Writer) {
 	scanner := bufio.NewScanner(in)
-	env := object.NewEnvironment()
+	env := evaluator.NewEnvironment()
 
 	for {
 		fmt.Fprintf(out, PROMPT)
@@ -13,6 +13,7 @@ const (
 	// Identifiers + literals
 	IDENT  TokenType = "IDENT" // add, foobar, x, y
 	INT    TokenType = "INT"
+	FLOAT  TokenType = "FLOAT"
 	STRING TokenType = "STRING"
 
 	// Operators
@@ -22,15 +23,33 @@ const (
 	BANG     TokenType = "!"
 	ASTERISK TokenType = "*"
 	SLASH    TokenType = "/"
+	PERCENT  TokenType = "%"
 	LT       TokenType = "<"
 	GT       TokenType = ">"
 	EQ       TokenType = "=="
 	NOT_EQ   TokenType = "!="
+	BAR      TokenType = "|"
+	NULLISH  TokenType = "??"
+	PIPE     TokenType = "|>"
+	QUESTION TokenType = "?"
+
+	// Compound assignment operators
+	PLUS_ASSIGN     TokenType = "+="
+	MINUS_ASSIGN    TokenType = "-="
+	ASTERISK_ASSIGN TokenType = "*="
+	SLASH_ASSIGN    TokenType = "/="
+	PERCENT_ASSIGN  TokenType = "%="
 
 	// Delimiters
 	COMMA     TokenType = ","
 	SEMICOLON TokenType = ";"
 	COLON     TokenType = ":"
+	DOT       TokenType = "."
+	ELLIPSIS  TokenType = "..."
+	RANGE     TokenType = ".."
+	RANGE_EQ  TokenType = "..="
+	ARROW     TokenType = "=>"
+	OPTIONAL  TokenType = "?."
 
 	LPAREN   TokenType = "("
 	RPAREN   TokenType = ")"
@@ -42,21 +61,51 @@ const (
 	// Keywords
 	FUNCTION TokenType = "FUNCTION"
 	LET      TokenType = "LET"
+	CONST    TokenType = "CONST"
 	TRUE     TokenType = "TRUE"
 	FALSE    TokenType = "FALSE"
+	NULL     TokenType = "NULL"
 	IF       TokenType = "IF"
 	ELSE     TokenType = "ELSE"
 	RETURN   TokenType = "RETURN"
+	MATCH    TokenType = "MATCH"
+	THROW    TokenType = "THROW"
+	TRY      TokenType = "TRY"
+	CATCH    TokenType = "CATCH"
+	FINALLY  TokenType = "FINALLY"
+	IMPORT   TokenType = "IMPORT"
+	EXPORT   TokenType = "EXPORT"
+	AS       TokenType = "AS"
+	STRUCT   TokenType = "STRUCT"
+	ENUM     TokenType = "ENUM"
 )
 
 var keywords = map[string]TokenType{
-	"fn":     FUNCTION,
-	"let":    LET,
-	"true":   TRUE,
-	"false":  FALSE,
-	"if":     IF,
-	"else":   ELSE,
-	"return": RETURN,
+	"fn":      FUNCTION,
+	"let":     LET,
+	"const":   CONST,
+	"true":    TRUE,
+	"false":   FALSE,
+	"null":    NULL,
+	"if":      IF,
+	"else":    ELSE,
+	"return":  RETURN,
+	"match":   MATCH,
+	"throw":   THROW,
+	"try":     TRY,
+	"catch":   CATCH,
+	"finally": FINALLY,
+	"import":  IMPORT,
+	"export":  EXPORT,
+	"as":      AS,
+	"struct":  STRUCT,
+	"enum":    ENUM,
+}
+
+// Reports whether a token is a keyword, such as the IF token read from "if"
+func IsKeyword(tok Token) bool {
+	keyword, ok := keywords[tok.Literal]
+	return ok && keyword == tok.Type
 }
 
 func LookupIdent(ident string) TokenType {